@@ -1,7 +1,10 @@
 package appcenter
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/bitrise-io/appcenter/client"
 	"github.com/bitrise-io/appcenter/model"
@@ -9,12 +12,12 @@ import (
 
 // AppAPI ...
 type AppAPI struct {
-	API            client.API
+	API            client.AppCenterAPI
 	ReleaseOptions model.ReleaseOptions
 }
 
 // CreateApplicationAPI ...
-func CreateApplicationAPI(api client.API, releaseOptions model.ReleaseOptions) AppAPI {
+func CreateApplicationAPI(api client.AppCenterAPI, releaseOptions model.ReleaseOptions) AppAPI {
 	return AppAPI{
 		API:            api,
 		ReleaseOptions: releaseOptions,
@@ -23,7 +26,12 @@ func CreateApplicationAPI(api client.API, releaseOptions model.ReleaseOptions) A
 
 // NewRelease ...
 func (a AppAPI) NewRelease() (model.Release, error) {
-	releaseID, err := a.API.CreateRelease(a.ReleaseOptions)
+	return a.NewReleaseContext(context.Background())
+}
+
+// NewReleaseContext ...
+func (a AppAPI) NewReleaseContext(ctx context.Context) (model.Release, error) {
+	releaseID, err := a.API.CreateReleaseContext(ctx, a.ReleaseOptions)
 	if err != nil {
 		return model.Release{},
 			fmt.Errorf("failed to create new release on app: %s, owner: %s, %v",
@@ -32,7 +40,69 @@ func (a AppAPI) NewRelease() (model.Release, error) {
 				err)
 	}
 
-	return a.API.GetAppReleaseDetails(a.ReleaseOptions.App, releaseID)
+	return a.API.GetAppReleaseDetailsContext(ctx, a.ReleaseOptions.App, releaseID)
+}
+
+// NewReleaseWithResult is like NewRelease, but also tells whether an existing release was reused,
+// see model.ReleaseOptions.ReuseExistingRelease.
+func (a AppAPI) NewReleaseWithResult() (model.Release, model.CreateReleaseResult, error) {
+	return a.NewReleaseWithResultContext(context.Background())
+}
+
+// NewReleaseWithResultContext ...
+func (a AppAPI) NewReleaseWithResultContext(ctx context.Context) (model.Release, model.CreateReleaseResult, error) {
+	result, err := a.API.CreateReleaseWithResultContext(ctx, a.ReleaseOptions)
+	if err != nil {
+		return model.Release{}, result,
+			fmt.Errorf("failed to create new release on app: %s, owner: %s, %v",
+				a.ReleaseOptions.App.AppName,
+				a.ReleaseOptions.App.Owner,
+				err)
+	}
+
+	release, err := a.API.GetAppReleaseDetailsContext(ctx, a.ReleaseOptions.App, result.ReleaseID)
+
+	return release, result, err
+}
+
+// ResumeRelease continues an interrupted NewRelease from ReleaseOptions.UploadSessionPath.
+func (a AppAPI) ResumeRelease() (model.Release, error) {
+	return a.ResumeReleaseContext(context.Background())
+}
+
+// ResumeReleaseContext ...
+func (a AppAPI) ResumeReleaseContext(ctx context.Context) (model.Release, error) {
+	releaseID, err := a.API.ResumeReleaseContext(ctx, a.ReleaseOptions)
+	if err != nil {
+		return model.Release{},
+			fmt.Errorf("failed to resume release on app: %s, owner: %s, %v",
+				a.ReleaseOptions.App.AppName,
+				a.ReleaseOptions.App.Owner,
+				err)
+	}
+
+	return a.API.GetAppReleaseDetailsContext(ctx, a.ReleaseOptions.App, releaseID)
+}
+
+// Releases ...
+func (a AppAPI) Releases(opts model.ReleaseListOptions) ([]model.ReleaseSummary, error) {
+	return a.API.ListReleases(a.ReleaseOptions.App, opts)
+}
+
+// FindReleaseByVersion ...
+func (a AppAPI) FindReleaseByVersion(shortVersion, build string) (model.Release, error) {
+	return a.API.FindReleaseByVersion(a.ReleaseOptions.App, shortVersion, build)
+}
+
+// FindReleaseByHash checks the maxReleases newest releases: zero checks the model.DefaultReuseLookupReleases newest releases,
+// a negative value checks every release.
+func (a AppAPI) FindReleaseByHash(sha256 string, maxReleases int) (model.Release, error) {
+	return a.API.FindReleaseByHash(a.ReleaseOptions.App, sha256, maxReleases)
+}
+
+// DeleteRelease ...
+func (a AppAPI) DeleteRelease(releaseID int) error {
+	return a.API.DeleteRelease(a.ReleaseOptions.App, releaseID)
 }
 
 // Groups ...
@@ -45,7 +115,93 @@ func (a AppAPI) AllGroups() ([]model.Group, error) {
 	return a.API.GetAllGroups(a.ReleaseOptions.App)
 }
 
+// CreateGroup ...
+func (a AppAPI) CreateGroup(opts model.GroupOptions) (model.Group, error) {
+	return a.API.CreateGroup(opts, a.ReleaseOptions.App)
+}
+
+// UpdateGroup ...
+func (a AppAPI) UpdateGroup(name string, update model.GroupUpdate) (model.Group, error) {
+	return a.API.UpdateGroup(name, update, a.ReleaseOptions.App)
+}
+
+// DeleteGroup ...
+func (a AppAPI) DeleteGroup(name string) error {
+	return a.API.DeleteGroup(name, a.ReleaseOptions.App)
+}
+
+// GroupMembers ...
+func (a AppAPI) GroupMembers(name string) ([]model.GroupMember, error) {
+	return a.API.GetGroupMembers(name, a.ReleaseOptions.App)
+}
+
+// AddGroupMembers invites the emails to the group. Blank and duplicate emails are dropped,
+// the emails which could not be added are returned in the error as well.
+func (a AppAPI) AddGroupMembers(name string, emails []string) ([]model.GroupMemberResult, error) {
+	emails = uniqueEmails(emails)
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	results, err := a.API.AddGroupMembers(name, emails, a.ReleaseOptions.App)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, result := range results {
+		if !result.Succeeded() {
+			errs = append(errs, fmt.Errorf("%s: %s (%d)", result.UserEmail, result.Message, result.Status))
+		}
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("failed to add %d member(s) to group %s: %w", len(errs), name, errors.Join(errs...))
+	}
+
+	return results, nil
+}
+
+// RemoveGroupMembers ...
+func (a AppAPI) RemoveGroupMembers(name string, emails []string) error {
+	emails = uniqueEmails(emails)
+	if len(emails) == 0 {
+		return nil
+	}
+
+	return a.API.RemoveGroupMembers(name, emails, a.ReleaseOptions.App)
+}
+
+func uniqueEmails(emails []string) []string {
+	var result []string
+	seen := map[string]bool{}
+	for _, email := range nonEmpty(emails) {
+		key := strings.ToLower(email)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, email)
+	}
+
+	return result
+}
+
 // Stores ...
 func (a AppAPI) Stores(name string) (model.Store, error) {
 	return a.API.GetStore(name, a.ReleaseOptions.App)
 }
+
+// AllStores ...
+func (a AppAPI) AllStores() ([]model.Store, error) {
+	return a.API.GetAllStores(a.ReleaseOptions.App)
+}
+
+// CreateStore ...
+func (a AppAPI) CreateStore(opts model.StoreOptions) (model.Store, error) {
+	return a.API.CreateStore(opts, a.ReleaseOptions.App)
+}
+
+// DeleteStore ...
+func (a AppAPI) DeleteStore(name string) error {
+	return a.API.DeleteStore(name, a.ReleaseOptions.App)
+}
@@ -0,0 +1,279 @@
+package appcenter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+var testApp = model.App{Owner: "owner", AppName: "app", AppType: model.AppTypeAndroid}
+
+func newTestAppAPI(server *appcentertest.Server) AppAPI {
+	api := client.New(appcentertest.Token,
+		client.WithBaseURL(server.URL),
+		client.WithPollInterval(time.Millisecond),
+		client.WithRetryMax(0),
+		client.WithLogger(client.NopLogger()))
+
+	return CreateApplicationAPI(api, model.ReleaseOptions{App: testApp})
+}
+
+func TestReleases(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	qa := server.AddGroup(testApp, model.Group{Name: "QA"})
+	for i := 1; i <= 3; i++ {
+		release := model.Release{ID: i}
+		if i != 2 {
+			release.DistributionGroups = []model.ReleaseDistributionGroup{{ID: qa.ID, Name: qa.Name}}
+		}
+		server.AddRelease(testApp, release)
+	}
+
+	app := newTestAppAPI(server)
+
+	page, err := app.Releases(model.ReleaseListOptions{Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != 2 {
+		t.Fatalf("Second newest release expected, got: %+v", page)
+	}
+
+	inGroup, err := app.Releases(model.ReleaseListOptions{GroupName: "QA"})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if len(inGroup) != 2 || inGroup[0].ID != 3 || inGroup[1].ID != 1 {
+		t.Fatalf("Releases 3 and 1 expected in QA, got: %+v", inGroup)
+	}
+}
+
+func TestFindRelease(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.AddRelease(testApp, model.Release{ID: 1, ShortVersion: "1.0", Version: "10", PackageHashes: []string{"aaa"}})
+	server.AddRelease(testApp, model.Release{ID: 2, ShortVersion: "1.0", Version: "11", PackageHashes: []string{"bbb"}})
+	server.AddRelease(testApp, model.Release{ID: 3, ShortVersion: "1.1", Version: "12", PackageHashes: []string{"ccc"}})
+
+	app := newTestAppAPI(server)
+
+	byVersion, err := app.FindReleaseByVersion("1.0", "")
+	if err != nil || byVersion.ID != 2 {
+		t.Fatalf("Newest 1.0 release expected, got: %+v, %v", byVersion, err)
+	}
+
+	byBuild, err := app.FindReleaseByVersion("1.0", "10")
+	if err != nil || byBuild.ID != 1 {
+		t.Fatalf("Release 1 expected, got: %+v, %v", byBuild, err)
+	}
+
+	byHash, err := app.FindReleaseByHash("BBB", 0)
+	if err != nil || byHash.ID != 2 {
+		t.Fatalf("Release 2 expected, got: %+v, %v", byHash, err)
+	}
+
+	if _, err := app.FindReleaseByHash("ddd", -1); !errors.Is(err, client.ErrReleaseNotFound) || !client.IsNotFound(err) {
+		t.Fatalf("ErrReleaseNotFound expected, got: %v", err)
+	}
+
+	before := server.RequestCount("GET", "/v0.1/apps/owner/app/releases/")
+	if _, err := app.FindReleaseByHash("aaa", 2); !errors.Is(err, client.ErrReleaseNotFound) {
+		t.Fatalf("Only the 2 newest releases expected to be checked, got: %v", err)
+	}
+	if details := server.RequestCount("GET", "/v0.1/apps/owner/app/releases/") - before; details != 2 {
+		t.Fatalf("2 release details requests expected, got: %d", details)
+	}
+}
+
+func TestNewReleaseWithResultReusesRelease(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	filePath := filepath.Join(t.TempDir(), "app.apk")
+	if err := os.WriteFile(filePath, []byte("package content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	app := newTestAppAPI(server)
+	app.ReleaseOptions.FilePath = filePath
+	app.ReleaseOptions.ReuseExistingRelease = true
+
+	first, result, err := app.NewReleaseWithResult()
+	if err != nil || result.Reused {
+		t.Fatalf("New release expected, got: %+v, %v", result, err)
+	}
+
+	second, result, err := app.NewReleaseWithResult()
+	if err != nil || !result.Reused || second.ID != first.ID {
+		t.Fatalf("Release %d expected to be reused, got: %+v, %v", first.ID, result, err)
+	}
+	if len(first.PackageHashes) != 1 || first.PackageHashes[0] != result.PackageHash {
+		t.Fatalf("Package hash %s expected, got: %v", result.PackageHash, first.PackageHashes)
+	}
+	if uploads := server.RequestCount("POST", "/v0.1/apps/owner/app/uploads/releases"); uploads != 1 {
+		t.Fatalf("1 upload expected, got: %d", uploads)
+	}
+}
+
+func TestGroupManagement(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	app := newTestAppAPI(server)
+
+	created, err := app.CreateGroup(model.GroupOptions{Name: "feature-login"})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if created.ID == "" || created.DisplayName != "feature-login" || created.IsPublic {
+		t.Fatalf("Private group with generated ID expected, got: %+v", created)
+	}
+
+	if _, err := app.CreateGroup(model.GroupOptions{Name: "feature-login"}); !client.IsConflict(err) {
+		t.Fatalf("Conflict expected, got: %v", err)
+	}
+
+	newName, displayName, public := "feature-signup", "Feature: signup", true
+	updated, err := app.UpdateGroup("feature-login", model.GroupUpdate{Name: &newName, DisplayName: &displayName, IsPublic: &public})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if updated.ID != created.ID || updated.Name != newName || updated.DisplayName != displayName || !updated.IsPublic {
+		t.Fatalf("Renamed public group expected, got: %+v", updated)
+	}
+
+	if err := app.DeleteGroup(newName); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if _, err := app.Groups(newName); !client.IsNotFound(err) {
+		t.Fatalf("Not found expected, got: %v", err)
+	}
+}
+
+func TestGroupManagementEscapesNames(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	app := newTestAppAPI(server)
+
+	if _, err := app.CreateGroup(model.GroupOptions{Name: "feature/login"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	newName := "feature/login?v=2#1"
+	if _, err := app.UpdateGroup("feature/login", model.GroupUpdate{Name: &newName}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if group, err := app.Groups(newName); err != nil || group.Name != newName {
+		t.Fatalf("Renamed group expected, got: %+v, %v", group, err)
+	}
+
+	if err := app.DeleteGroup(newName); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if _, err := app.Groups(newName); !client.IsNotFound(err) {
+		t.Fatalf("Not found expected, got: %v", err)
+	}
+}
+
+func TestGroupMembers(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.AddGroup(testApp, model.Group{Name: "beta"})
+	server.AddGroupMember(testApp, "beta", model.GroupMember{Email: "lead@example.com"})
+	app := newTestAppAPI(server)
+
+	results, err := app.AddGroupMembers("beta", []string{"qa@example.com", " ", "QA@example.com", "lead@example.com", "invalid"})
+	if err == nil {
+		t.Fatal("Error expected for the existing member and the invalid email")
+	}
+	if len(results) != 3 || !results[0].Succeeded() || results[1].Succeeded() || results[2].Succeeded() {
+		t.Fatalf("Only qa@example.com should be added, got: %+v", results)
+	}
+
+	members, err := app.GroupMembers("beta")
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if len(members) != 2 || members[0].InvitePending || !members[1].InvitePending {
+		t.Fatalf("Accepted lead and pending qa member expected, got: %+v", members)
+	}
+
+	if err := app.RemoveGroupMembers("beta", []string{"lead@example.com"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if members, _ := app.GroupMembers("beta"); len(members) != 1 || members[0].Email != "qa@example.com" {
+		t.Fatalf("Only qa member expected, got: %+v", members)
+	}
+}
+
+func TestGroupMembersEscapesNames(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.AddGroup(testApp, model.Group{Name: "feature/login"})
+	app := newTestAppAPI(server)
+
+	if _, err := app.AddGroupMembers("feature/login", []string{"qa@example.com"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if members, err := app.GroupMembers("feature/login"); err != nil || len(members) != 1 {
+		t.Fatalf("1 member expected, got: %+v, %v", members, err)
+	}
+	if err := app.RemoveGroupMembers("feature/login", []string{"qa@example.com"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if members, _ := app.GroupMembers("feature/login"); len(members) != 0 {
+		t.Fatalf("No members expected, got: %+v", members)
+	}
+}
+
+func TestStoreManagement(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.AddStore(testApp, model.Store{Name: "Production", Type: model.StoreTypeGooglePlay, Track: model.StoreTrackProduction})
+	server.AddStore(testApp, model.Store{Name: "Alpha/QA", Type: model.StoreTypeGooglePlay, Track: model.StoreTrackAlpha})
+	app := newTestAppAPI(server)
+
+	if _, err := app.CreateStore(model.StoreOptions{Name: "Beta", Type: model.StoreTypeGooglePlay, Track: model.StoreTrackTestflightInternal, ServiceConnectionID: "conn"}); err == nil {
+		t.Fatal("Error expected for a track not available for the store type")
+	}
+
+	created, err := app.CreateStore(model.StoreOptions{Name: "Beta", Type: model.StoreTypeGooglePlay, Track: model.StoreTrackBeta, ServiceConnectionID: "conn"})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if created.ID == "" || created.Track != model.StoreTrackBeta || created.ServiceConnectionID != "conn" {
+		t.Fatalf("Beta track store expected, got: %+v", created)
+	}
+
+	stores, err := app.AllStores()
+	if err != nil || len(stores) != 3 || stores[0].Name != "Alpha/QA" || stores[1].Name != "Beta" || stores[2].Name != "Production" {
+		t.Fatalf("Alpha/QA, Beta and Production stores expected, got: %+v, %v", stores, err)
+	}
+
+	if err := app.DeleteStore("Beta"); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if _, err := app.Stores("Beta"); !client.IsNotFound(err) {
+		t.Fatalf("Not found expected, got: %v", err)
+	}
+
+	if err := app.DeleteStore("Alpha/QA"); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if _, err := app.Stores("Alpha/QA"); !client.IsNotFound(err) {
+		t.Fatalf("Not found expected, got: %v", err)
+	}
+}
@@ -0,0 +1,1256 @@
+package appcentertest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+type params map[string]string
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, p params)
+
+type route struct {
+	method  string
+	pattern []string
+	handler handlerFunc
+}
+
+func (s *Server) routes() []route {
+	return []route{
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/uploads/releases", s.createUpload),
+		newRoute(http.MethodPatch, "/v0.1/apps/{owner}/{app}/uploads/releases/{id}", s.patchUpload),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/uploads/releases/{id}", s.getUpload),
+		newRoute(http.MethodPost, "/upload/set_metadata/{asset}", s.setMetadata),
+		newRoute(http.MethodPost, "/upload/upload_chunk/{asset}", s.uploadChunk),
+		newRoute(http.MethodPost, "/upload/finished/{asset}", s.finishUpload),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/releases", s.listReleases),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/distribution_groups/{name}/releases", s.listGroupReleases),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/releases/{id}", s.getRelease),
+		newRoute(http.MethodPut, "/v0.1/apps/{owner}/{app}/releases/{id}", s.updateRelease),
+		newRoute(http.MethodPatch, "/v0.1/apps/{owner}/{app}/releases/{id}", s.patchRelease),
+		newRoute(http.MethodDelete, "/v0.1/apps/{owner}/{app}/releases/{id}", s.deleteRelease),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/releases/{id}/groups", s.addReleaseGroup),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/releases/{id}/stores", s.addReleaseStore),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/releases/{id}/testers", s.addReleaseTester),
+		newRoute(http.MethodDelete, "/v0.1/apps/{owner}/{app}/releases/{id}/groups/{destination}", s.removeReleaseGroup),
+		newRoute(http.MethodDelete, "/v0.1/apps/{owner}/{app}/releases/{id}/stores/{destination}", s.removeReleaseStore),
+		newRoute(http.MethodDelete, "/v0.1/apps/{owner}/{app}/releases/{id}/testers/{destination}", s.removeReleaseTester),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/distribution_groups", s.listGroups),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/distribution_groups", s.createGroup),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/distribution_groups/{name}", s.getGroup),
+		newRoute(http.MethodPatch, "/v0.1/apps/{owner}/{app}/distribution_groups/{name}", s.updateGroup),
+		newRoute(http.MethodDelete, "/v0.1/apps/{owner}/{app}/distribution_groups/{name}", s.deleteGroup),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/distribution_groups/{name}/members", s.listGroupMembers),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/distribution_groups/{name}/members", s.addGroupMembers),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/distribution_groups/{name}/members/bulk_delete", s.removeGroupMembers),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/distribution_stores", s.listStores),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/distribution_stores", s.createStore),
+		newRoute(http.MethodGet, "/v0.1/apps/{owner}/{app}/distribution_stores/{name}", s.getStore),
+		newRoute(http.MethodDelete, "/v0.1/apps/{owner}/{app}/distribution_stores/{name}", s.deleteStore),
+		newRoute(http.MethodGet, "/v0.1/orgs/{org}/distribution_groups", s.listOrgGroups),
+		newRoute(http.MethodPost, "/v0.1/orgs/{org}/distribution_groups", s.createOrgGroup),
+		newRoute(http.MethodGet, "/v0.1/orgs/{org}/distribution_groups/{name}", s.getOrgGroup),
+		newRoute(http.MethodPost, "/v0.1/orgs/{org}/distribution_groups/{name}/apps", s.addOrgGroupApps),
+		newRoute(http.MethodPost, "/v0.1/apps/{owner}/{app}/symbol_uploads", s.createSymbolUpload),
+		newRoute(http.MethodPatch, "/v0.1/apps/{owner}/{app}/symbol_uploads/{id}", s.patchSymbolUpload),
+		newRoute(http.MethodPut, "/symbol_blobs/{id}", s.uploadSymbolBlob),
+	}
+}
+
+func newRoute(method, pattern string, handler handlerFunc) route {
+	return route{
+		method:  method,
+		pattern: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler: handler,
+	}
+}
+
+func (rt route) match(r *http.Request) (params, bool) {
+	if rt.method != r.Method {
+		return nil, false
+	}
+
+	// The escaped path is split, so an escaped "/" stays part of its segment.
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, false
+		}
+		segments[i] = unescaped
+	}
+
+	p := params{}
+	for i, segment := range rt.pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			p[strings.Trim(segment, "{}")] = segments[i]
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return p, true
+}
+
+func (p params) app() model.App {
+	return model.App{Owner: p["owner"], AppName: p["app"]}
+}
+
+func (p params) releaseID() (int, bool) {
+	id, err := strconv.Atoi(p["id"])
+	return id, err == nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	faultStatus := s.takeFault(r)
+	chunkDelay := s.chunkDelay
+	s.mu.Unlock()
+
+	if faultStatus != 0 {
+		writeError(w, faultStatus, "InjectedFault", "fault injected by appcentertest")
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/v0.1/") && r.Header.Get("x-api-token") != Token {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "invalid api token")
+		return
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/upload/upload_chunk/") && chunkDelay > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(chunkDelay):
+		}
+	}
+
+	for _, rt := range s.routes() {
+		if p, ok := rt.match(r); ok {
+			rt.handler(w, r, p)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "NotFound", "no route for "+r.Method+" "+r.URL.Path)
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(b) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+func (s *Server) createUpload(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := &uploadState{
+		id:      s.newID("upload"),
+		assetID: s.newID("asset"),
+		token:   s.newID("token"),
+		owner:   p["owner"],
+		app:     p["app"],
+		chunks:  map[int][]byte{},
+	}
+	s.uploads[u.assetID] = u
+
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"id":                u.id,
+		"package_asset_id":  u.assetID,
+		"token":             u.token,
+		"upload_domain":     s.URL,
+		"url_encoded_token": u.token,
+	})
+}
+
+// upload returns the upload of the asset in the path, if the token query parameter matches.
+func (s *Server) upload(w http.ResponseWriter, r *http.Request, p params) (*uploadState, bool) {
+	u, ok := s.uploads[p["asset"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "unknown package asset")
+		return nil, false
+	}
+	if r.URL.Query().Get("token") != u.token {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "invalid upload token")
+		return nil, false
+	}
+
+	return u, true
+}
+
+func (s *Server) setMetadata(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.upload(w, r, p)
+	if !ok {
+		return
+	}
+
+	fileSize, err := strconv.ParseInt(r.URL.Query().Get("file_size"), 10, 64)
+	if err != nil || fileSize <= 0 {
+		writeError(w, http.StatusBadRequest, "BadRequest", "invalid file_size")
+		return
+	}
+
+	u.fileName = r.URL.Query().Get("file_name")
+	u.fileSize = fileSize
+	u.appOs = "iOS"
+	if r.URL.Query().Get("content_type") == "application/vnd.android.package-archive" {
+		u.appOs = "Android"
+	}
+
+	u.chunkList = nil
+	for i := int64(0); i*int64(s.chunkSize) < fileSize; i++ {
+		u.chunkList = append(u.chunkList, int(i)+1)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":              u.assetID,
+		"chunk_size":      s.chunkSize,
+		"chunk_list":      u.chunkList,
+		"blob_partitions": 1,
+	})
+}
+
+func (s *Server) uploadChunk(w http.ResponseWriter, r *http.Request, p params) {
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.upload(w, r, p)
+	if !ok {
+		return
+	}
+
+	blockNumber, err := strconv.Atoi(r.URL.Query().Get("block_number"))
+	if err != nil || blockNumber < 1 || blockNumber > len(u.chunkList) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"error": true, "error_code": "InvalidBlockNumber"})
+		return
+	}
+
+	u.chunks[blockNumber] = content
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"error": false, "chunk_num": blockNumber})
+}
+
+func (s *Server) finishUpload(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.upload(w, r, p)
+	if !ok {
+		return
+	}
+
+	var content []byte
+	for _, id := range u.chunkList {
+		chunk, ok := u.chunks[id]
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": true, "error_code": "ChunkMissing"})
+			return
+		}
+		content = append(content, chunk...)
+	}
+
+	if int64(len(content)) != u.fileSize {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": true, "error_code": "FileSizeMismatch"})
+		return
+	}
+
+	u.content = content
+	u.finished = true
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"error": false, "state": "Done"})
+}
+
+func (s *Server) uploadByID(p params) (*uploadState, bool) {
+	for _, u := range s.uploads {
+		if u.id == p["id"] && u.owner == p["owner"] && u.app == p["app"] {
+			return u, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) patchUpload(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		UploadStatus string `json:"upload_status"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploadByID(p)
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "unknown upload")
+		return
+	}
+	if body.UploadStatus != "uploadFinished" || !u.finished {
+		writeError(w, http.StatusBadRequest, "BadRequest", "upload is not finished")
+		return
+	}
+
+	u.patched = true
+
+	writeJSON(w, http.StatusOK, map[string]string{"id": u.id, "upload_status": "uploadFinished"})
+}
+
+func (s *Server) getUpload(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploadByID(p)
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "unknown upload")
+		return
+	}
+
+	response := map[string]interface{}{"id": u.id}
+	switch {
+	case !u.patched:
+		response["upload_status"] = "uploadStarted"
+	case u.polls < s.processingPolls:
+		u.polls++
+		response["upload_status"] = "uploadFinished"
+	default:
+		response["upload_status"] = s.uploadStatus
+		if s.uploadStatus == "readyToBePublished" {
+			if u.releaseID == 0 {
+				u.releaseID = s.createRelease(p.app(), u)
+			}
+			response["release_distinct_id"] = u.releaseID
+		}
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) createRelease(app model.App, u *uploadState) int {
+	hash := sha256.Sum256(u.content)
+
+	r := &model.Release{
+		ID:            s.nextID,
+		AppName:       app.AppName,
+		AppOs:         u.appOs,
+		Version:       s.nextVersion,
+		ShortVersion:  s.nextShortVersion,
+		Size:          len(u.content),
+		PackageHashes: []string{hex.EncodeToString(hash[:])},
+		UploadedAt:    time.Now().UTC().Format(time.RFC3339),
+		Enabled:       true,
+		Status:        "available",
+	}
+	s.nextID++
+	s.app(app).releases[r.ID] = r
+
+	return r.ID
+}
+
+func (s *Server) release(w http.ResponseWriter, p params) (*model.Release, bool) {
+	id, ok := p.releaseID()
+	if ok {
+		var r *model.Release
+		if r, ok = s.app(p.app()).releases[id]; ok {
+			return r, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "not_found", "release not found")
+	return nil, false
+}
+
+func (s *Server) getRelease(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	s.advanceStorePublishing(p.app(), release)
+
+	writeJSON(w, http.StatusOK, release)
+}
+
+// advanceStorePublishing counts the requests of the submitted store destinations and reports the
+// publishing status set with SetStorePublishing once they exceed the configured polls.
+func (s *Server) advanceStorePublishing(app model.App, release *model.Release) {
+	storePolls := s.app(app).storePolls
+	for i, store := range release.DistributionStores {
+		if store.PublishingStatus != model.PublishingStatusSubmitted {
+			continue
+		}
+
+		key := strconv.Itoa(release.ID) + "/" + store.ID
+		storePolls[key]++
+		if storePolls[key] <= s.publishPolls {
+			continue
+		}
+
+		release.DistributionStores[i].PublishingStatus = s.publishStatus
+		for j, d := range release.Destinations {
+			if d.DestinationType == "store" && d.ID == store.ID {
+				release.Destinations[j].PublishingStatus = s.publishStatus
+			}
+		}
+	}
+}
+
+func (s *Server) listReleases(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	publishedOnly := r.URL.Query().Get("published_only") == "true"
+
+	writeJSON(w, http.StatusOK, s.releaseSummaries(p.app(), func(release *model.Release) bool {
+		return !publishedOnly || len(release.Destinations) > 0
+	}))
+}
+
+func (s *Server) listGroupReleases(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	group, ok := s.app(p.app()).groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.releaseSummaries(p.app(), func(release *model.Release) bool {
+		for _, g := range release.DistributionGroups {
+			if g.ID == group.ID {
+				return true
+			}
+		}
+		return false
+	}))
+}
+
+// releaseSummaries returns the matching releases of the app from the newest one.
+func (s *Server) releaseSummaries(app model.App, match func(*model.Release) bool) []model.ReleaseSummary {
+	summaries := []model.ReleaseSummary{}
+	for _, release := range s.app(app).releases {
+		if !match(release) {
+			continue
+		}
+
+		summaries = append(summaries, model.ReleaseSummary{
+			ID:                 release.ID,
+			Version:            release.Version,
+			ShortVersion:       release.ShortVersion,
+			Origin:             release.Origin,
+			UploadedAt:         release.UploadedAt,
+			Enabled:            release.Enabled,
+			IsExternalBuild:    release.IsExternalBuild,
+			DistributionGroups: release.DistributionGroups,
+			DistributionStores: release.DistributionStores,
+			Destinations:       release.Destinations,
+			Build:              release.Build,
+		})
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ID > summaries[j].ID
+	})
+
+	return summaries
+}
+
+func (s *Server) updateRelease(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		ReleaseNotes *string             `json:"release_notes"`
+		Build        *model.ReleaseBuild `json:"build"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	if body.ReleaseNotes != nil {
+		release.ReleaseNotes = *body.ReleaseNotes
+	}
+	if body.Build != nil {
+		release.Build = *body.Build
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"release_notes": release.ReleaseNotes, "build": release.Build})
+}
+
+func (s *Server) patchRelease(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		Enabled      *bool   `json:"enabled"`
+		ReleaseNotes *string `json:"release_notes"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	if body.Enabled != nil {
+		release.Enabled = *body.Enabled
+	}
+	if body.ReleaseNotes != nil {
+		release.ReleaseNotes = *body.ReleaseNotes
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"enabled": release.Enabled, "release_notes": release.ReleaseNotes})
+}
+
+func (s *Server) deleteRelease(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	delete(s.app(p.app()).releases, release.ID)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) addReleaseGroup(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		ID string `json:"id"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	group := s.distributionGroup(p.app(), body.ID)
+	if group == nil {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	for _, g := range release.DistributionGroups {
+		if g.ID == group.ID {
+			writeError(w, http.StatusConflict, "Conflict", "release is already distributed to the group")
+			return
+		}
+	}
+
+	release.DistributionGroups = append(release.DistributionGroups, model.ReleaseDistributionGroup{ID: group.ID, Name: group.Name})
+	release.Destinations = append(release.Destinations, model.ReleaseDestination{
+		ID:              group.ID,
+		Name:            group.Name,
+		DisplayName:     group.DisplayName,
+		Type:            "group",
+		DestinationType: "group",
+		IsLatest:        true,
+	})
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": group.ID})
+}
+
+func (s *Server) addReleaseStore(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		ID string `json:"id"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	var store *model.Store
+	for _, st := range s.app(p.app()).stores {
+		if st.ID == body.ID {
+			store = st
+		}
+	}
+	if store == nil {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution store not found")
+		return
+	}
+
+	release.DistributionStores = append(release.DistributionStores, model.ReleaseDistributionStore{
+		ID:               store.ID,
+		Name:             store.Name,
+		Type:             store.Type,
+		PublishingStatus: model.PublishingStatusSubmitted,
+	})
+	release.Destinations = append(release.Destinations, model.ReleaseDestination{
+		ID:               store.ID,
+		Name:             store.Name,
+		DisplayName:      store.Name,
+		Type:             store.Type,
+		DestinationType:  "store",
+		PublishingStatus: model.PublishingStatusSubmitted,
+		IsLatest:         true,
+	})
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": store.ID})
+}
+
+func (s *Server) addReleaseTester(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		Email string `json:"email"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	if !strings.Contains(body.Email, "@") {
+		writeError(w, http.StatusBadRequest, "BadRequest", "invalid email")
+		return
+	}
+
+	testers := s.app(p.app()).testers
+	for _, email := range testers[release.ID] {
+		if strings.EqualFold(email, body.Email) {
+			writeError(w, http.StatusConflict, "Conflict", "release is already distributed to the tester")
+			return
+		}
+	}
+
+	testers[release.ID] = append(testers[release.ID], body.Email)
+	id := s.newID("tester")
+	release.Destinations = append(release.Destinations, model.ReleaseDestination{
+		ID:              id,
+		Name:            body.Email,
+		DisplayName:     body.Email,
+		Type:            "tester",
+		DestinationType: "tester",
+		IsLatest:        true,
+	})
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"id": id})
+}
+
+func (s *Server) removeReleaseGroup(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	groups := release.DistributionGroups[:0]
+	for _, g := range release.DistributionGroups {
+		if g.ID != p["destination"] {
+			groups = append(groups, g)
+		}
+	}
+	release.DistributionGroups = groups
+
+	s.removeDestination(w, release, "group", p["destination"])
+}
+
+func (s *Server) removeReleaseStore(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	stores := release.DistributionStores[:0]
+	for _, st := range release.DistributionStores {
+		if st.ID != p["destination"] {
+			stores = append(stores, st)
+		}
+	}
+	release.DistributionStores = stores
+
+	s.removeDestination(w, release, "store", p["destination"])
+}
+
+func (s *Server) removeReleaseTester(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	release, ok := s.release(w, p)
+	if !ok {
+		return
+	}
+
+	for _, d := range release.Destinations {
+		if d.DestinationType == "tester" && d.ID == p["destination"] {
+			testers := s.app(p.app()).testers
+			emails := testers[release.ID][:0]
+			for _, email := range testers[release.ID] {
+				if !strings.EqualFold(email, d.Name) {
+					emails = append(emails, email)
+				}
+			}
+			testers[release.ID] = emails
+		}
+	}
+
+	s.removeDestination(w, release, "tester", p["destination"])
+}
+
+// removeDestination removes the destination from the release and writes the response,
+// 404 if the release was not distributed to it.
+func (s *Server) removeDestination(w http.ResponseWriter, release *model.Release, destinationType, id string) {
+	found := false
+	destinations := release.Destinations[:0]
+	for _, d := range release.Destinations {
+		if d.DestinationType == destinationType && d.ID == id {
+			found = true
+			continue
+		}
+		destinations = append(destinations, d)
+	}
+	release.Destinations = destinations
+
+	if !found {
+		writeError(w, http.StatusNotFound, "NotFound", destinationType+" destination not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := []model.Group{}
+	for _, g := range s.app(p.app()).groups {
+		groups = append(groups, *g)
+	}
+
+	writeJSON(w, http.StatusOK, groups)
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.app(p.app()).groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request, p params) {
+	var body model.GroupOptions
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "BadRequest", "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := s.app(p.app()).groups
+	if _, ok := groups[body.Name]; ok {
+		writeError(w, http.StatusConflict, "Conflict", "distribution group already exists")
+		return
+	}
+
+	g := &model.Group{
+		ID:          s.newID("group"),
+		Name:        body.Name,
+		DisplayName: body.DisplayName,
+		Origin:      "appcenter",
+		IsPublic:    body.IsPublic,
+	}
+	if g.DisplayName == "" {
+		g.DisplayName = g.Name
+	}
+	groups[g.Name] = g
+
+	writeJSON(w, http.StatusCreated, g)
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request, p params) {
+	var body model.GroupUpdate
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := s.app(p.app()).groups
+	g, ok := groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	if body.Name != nil && *body.Name != g.Name {
+		if _, ok := groups[*body.Name]; ok {
+			writeError(w, http.StatusConflict, "Conflict", "distribution group already exists")
+			return
+		}
+		delete(groups, g.Name)
+		g.Name = *body.Name
+		groups[g.Name] = g
+	}
+	if body.DisplayName != nil {
+		g.DisplayName = *body.DisplayName
+	}
+	if body.IsPublic != nil {
+		g.IsPublic = *body.IsPublic
+	}
+
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := s.app(p.app())
+	g, ok := a.groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+	delete(a.groups, g.Name)
+	delete(a.members, g.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listGroupMembers(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := s.app(p.app())
+	g, ok := a.groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, append([]model.GroupMember{}, a.members[g.ID]...))
+}
+
+func (s *Server) addGroupMembers(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		UserEmails []string `json:"user_emails"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := s.app(p.app())
+	g, ok := a.groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	results := []model.GroupMemberResult{}
+	for _, email := range body.UserEmails {
+		result := model.GroupMemberResult{UserEmail: email, Status: http.StatusOK}
+		switch {
+		case !strings.Contains(email, "@"):
+			result.Status, result.Code, result.Message = http.StatusBadRequest, "BadRequest", "invalid email"
+		case hasMember(a.members[g.ID], email):
+			result.Status, result.Code, result.Message = http.StatusConflict, "Conflict", "user is already a member"
+		default:
+			result.InvitePending = true
+			a.members[g.ID] = append(a.members[g.ID], model.GroupMember{
+				ID:            s.newID("user"),
+				Email:         email,
+				InvitePending: true,
+			})
+		}
+		results = append(results, result)
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) removeGroupMembers(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		UserEmails []string `json:"user_emails"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := s.app(p.app())
+	g, ok := a.groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	members := a.members[g.ID][:0]
+	for _, m := range a.members[g.ID] {
+		if !containsFold(body.UserEmails, m.Email) {
+			members = append(members, m)
+		}
+	}
+	a.members[g.ID] = members
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func hasMember(members []model.GroupMember, email string) bool {
+	for _, m := range members {
+		if strings.EqualFold(m.Email, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) listOrgGroups(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := []model.Group{}
+	for _, g := range s.org(p["org"]).groups {
+		groups = append(groups, *g)
+	}
+
+	writeJSON(w, http.StatusOK, groups)
+}
+
+func (s *Server) createOrgGroup(w http.ResponseWriter, r *http.Request, p params) {
+	var body model.GroupOptions
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "BadRequest", "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := s.org(p["org"]).groups
+	if _, ok := groups[body.Name]; ok {
+		writeError(w, http.StatusConflict, "Conflict", "distribution group already exists")
+		return
+	}
+
+	g := &model.Group{
+		ID:          s.newID("group"),
+		Name:        body.Name,
+		DisplayName: body.DisplayName,
+		Origin:      "appcenter",
+		IsPublic:    body.IsPublic,
+	}
+	if g.DisplayName == "" {
+		g.DisplayName = g.Name
+	}
+	groups[g.Name] = g
+
+	writeJSON(w, http.StatusCreated, g)
+}
+
+func (s *Server) getOrgGroup(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.org(p["org"]).groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (s *Server) addOrgGroupApps(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		Apps []struct {
+			Name string `json:"name"`
+		} `json:"apps"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.org(p["org"])
+	g, ok := o.groups[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution group not found")
+		return
+	}
+
+	for _, app := range body.Apps {
+		if !containsFold(o.groupApps[g.ID], app.Name) {
+			o.groupApps[g.ID] = append(o.groupApps[g.ID], app.Name)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getStore(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.app(p.app()).stores[p["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution store not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, st)
+}
+
+func (s *Server) listStores(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stores := []model.Store{}
+	for _, st := range s.app(p.app()).stores {
+		stores = append(stores, *st)
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Name < stores[j].Name })
+
+	writeJSON(w, http.StatusOK, stores)
+}
+
+func (s *Server) createStore(w http.ResponseWriter, r *http.Request, p params) {
+	var body model.StoreOptions
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+	if err := body.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stores := s.app(p.app()).stores
+	if _, ok := stores[body.Name]; ok {
+		writeError(w, http.StatusConflict, "Conflict", "distribution store already exists")
+		return
+	}
+
+	st := &model.Store{
+		ID:                  s.newID("store"),
+		Name:                body.Name,
+		Type:                body.Type,
+		Track:               body.Track,
+		ServiceConnectionID: body.ServiceConnectionID,
+	}
+	if body.IntuneDetails != nil {
+		st.IntuneDetails = *body.IntuneDetails
+	}
+	stores[st.Name] = st
+
+	writeJSON(w, http.StatusCreated, st)
+}
+
+func (s *Server) deleteStore(w http.ResponseWriter, r *http.Request, p params) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stores := s.app(p.app()).stores
+	if _, ok := stores[p["name"]]; !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "distribution store not found")
+		return
+	}
+	delete(stores, p["name"])
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createSymbolUpload(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		SymbolType string `json:"symbol_type"`
+		FileName   string `json:"file_name"`
+		Build      string `json:"build"`
+		Version    string `json:"version"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := &SymbolUpload{
+		ID:         s.newID("symbol"),
+		SymbolType: body.SymbolType,
+		FileName:   body.FileName,
+		Build:      body.Build,
+		Version:    body.Version,
+		Status:     "created",
+	}
+	s.app(p.app()).symbolUploads[u.ID] = u
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"symbol_upload_id": u.ID,
+		"upload_url":       s.URL + "/symbol_blobs/" + u.ID + "?sig=appcentertest-signature",
+		"expiration_date":  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+	})
+}
+
+func (s *Server) symbolUpload(id string) (*SymbolUpload, bool) {
+	for _, a := range s.apps {
+		if u, ok := a.symbolUploads[id]; ok {
+			return u, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) uploadSymbolBlob(w http.ResponseWriter, r *http.Request, p params) {
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.symbolUpload(p["id"])
+	if !ok || r.URL.Query().Get("sig") == "" {
+		writeError(w, http.StatusForbidden, "AuthenticationFailed", "invalid upload url")
+		return
+	}
+	if r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+		writeError(w, http.StatusBadRequest, "MissingRequiredHeader", "x-ms-blob-type header is required")
+		return
+	}
+
+	u.Size = len(content)
+	u.Status = "uploaded"
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) patchSymbolUpload(w http.ResponseWriter, r *http.Request, p params) {
+	var body struct {
+		Status string `json:"status"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.app(p.app()).symbolUploads[p["id"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "symbol upload not found")
+		return
+	}
+
+	u.Status = body.Status
+
+	writeJSON(w, http.StatusOK, map[string]string{"symbol_upload_id": u.ID, "status": u.Status})
+}
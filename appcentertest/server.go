@@ -0,0 +1,425 @@
+// Package appcentertest provides an in-process fake AppCenter server for testing deploy flows offline.
+package appcentertest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// Token is the API token accepted by the Server.
+const Token = "appcentertest-token"
+
+// DefaultChunkSize is the chunk size returned by set_metadata, unless changed with SetChunkSize.
+const DefaultChunkSize = 4 * 1024 * 1024
+
+// SymbolUpload ...
+type SymbolUpload struct {
+	ID         string
+	SymbolType string
+	FileName   string
+	Build      string
+	Version    string
+	Size       int
+	Status     string
+}
+
+// Server is a stateful fake of the AppCenter API and its upload domain.
+// Use its URL with client.WithBaseURL and authenticate with Token.
+type Server struct {
+	*httptest.Server
+
+	mu               sync.Mutex
+	apps             map[string]*appState
+	orgs             map[string]*orgState
+	uploads          map[string]*uploadState
+	faults           []*fault
+	requests         []string
+	nextID           int
+	nextObjectID     int
+	chunkSize        int
+	chunkDelay       time.Duration
+	uploadStatus     string
+	processingPolls  int
+	publishStatus    string
+	publishPolls     int
+	nextShortVersion string
+	nextVersion      string
+}
+
+type appState struct {
+	releases      map[int]*model.Release
+	groups        map[string]*model.Group
+	members       map[string][]model.GroupMember
+	stores        map[string]*model.Store
+	testers       map[int][]string
+	storePolls    map[string]int
+	symbolUploads map[string]*SymbolUpload
+}
+
+type orgState struct {
+	groups map[string]*model.Group
+	// groupApps are the names of the apps attached to the shared groups by group ID
+	groupApps map[string][]string
+}
+
+type uploadState struct {
+	id        string
+	assetID   string
+	token     string
+	owner     string
+	app       string
+	appOs     string
+	fileName  string
+	fileSize  int64
+	chunkList []int
+	chunks    map[int][]byte
+	finished  bool
+	patched   bool
+	polls     int
+	releaseID int
+	content   []byte
+}
+
+type fault struct {
+	method     string
+	pathPrefix string
+	statusCode int
+	remaining  int
+}
+
+// NewServer starts a Server, it needs to be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		apps:             map[string]*appState{},
+		orgs:             map[string]*orgState{},
+		uploads:          map[string]*uploadState{},
+		nextID:           1,
+		nextObjectID:     1,
+		chunkSize:        DefaultChunkSize,
+		uploadStatus:     "readyToBePublished",
+		publishStatus:    model.PublishingStatusPublished,
+		nextShortVersion: "1.0",
+		nextVersion:      "1",
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// SetChunkSize sets the chunk size returned by set_metadata.
+func (s *Server) SetChunkSize(size int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.chunkSize = size
+}
+
+// SetChunkDelay delays every chunk upload response, to simulate a slow upload.
+func (s *Server) SetChunkDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.chunkDelay = d
+}
+
+// SetUploadStatus sets the upload status reported once a release upload is processed,
+// for example "malwareDetected" or "error". Defaults to "readyToBePublished".
+func (s *Server) SetUploadStatus(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.uploadStatus = status
+}
+
+// SetProcessingPolls sets how many status requests report "uploadFinished" before the upload status is reported.
+func (s *Server) SetProcessingPolls(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processingPolls = n
+}
+
+// SetStorePublishing sets how many release requests report a store destination as "submitted"
+// before the given publishing status is reported, for example model.PublishingStatusFailed.
+// Defaults to model.PublishingStatusPublished on the first request.
+func (s *Server) SetStorePublishing(status string, polls int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.publishStatus = status
+	s.publishPolls = polls
+}
+
+// SetReleaseVersion sets the versions of the releases created by subsequent uploads.
+func (s *Server) SetReleaseVersion(shortVersion, version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextShortVersion = shortVersion
+	s.nextVersion = version
+}
+
+// FailRequests makes the next n requests with the given method and path prefix fail with statusCode.
+// An empty method matches every method.
+func (s *Server) FailRequests(method, pathPrefix string, statusCode, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{method: method, pathPrefix: pathPrefix, statusCode: statusCode, remaining: n})
+}
+
+// Requests returns the "METHOD path" of every request received so far.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+// RequestCount returns the number of received requests with the given method and path prefix.
+func (s *Server) RequestCount(method, pathPrefix string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count := 0
+	for _, r := range s.requests {
+		m, path, _ := strings.Cut(r, " ")
+		if (method == "" || m == method) && strings.HasPrefix(path, pathPrefix) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// AddGroup adds a distribution group to the app, a missing ID is generated.
+func (s *Server) AddGroup(app model.App, g model.Group) model.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g.ID == "" {
+		g.ID = s.newID("group")
+	}
+	if g.DisplayName == "" {
+		g.DisplayName = g.Name
+	}
+	s.app(app).groups[g.Name] = &g
+
+	return g
+}
+
+// AddGroupMember adds a member to the distribution group with the given name, a missing ID is generated.
+// It returns false if the group does not exist.
+func (s *Server) AddGroupMember(app model.App, groupName string, m model.GroupMember) (model.GroupMember, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := s.app(app)
+	g, ok := a.groups[groupName]
+	if !ok {
+		return model.GroupMember{}, false
+	}
+
+	if m.ID == "" {
+		m.ID = s.newID("user")
+	}
+	a.members[g.ID] = append(a.members[g.ID], m)
+
+	return m, true
+}
+
+// AddOrgGroup adds a shared distribution group to the organization and attaches the given apps to it,
+// a missing ID is generated.
+func (s *Server) AddOrgGroup(orgName string, g model.Group, appNames ...string) model.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if g.ID == "" {
+		g.ID = s.newID("group")
+	}
+	if g.DisplayName == "" {
+		g.DisplayName = g.Name
+	}
+	o := s.org(orgName)
+	o.groups[g.Name] = &g
+	o.groupApps[g.ID] = append(o.groupApps[g.ID], appNames...)
+
+	return g
+}
+
+// AddStore adds a distribution store to the app, a missing ID is generated.
+func (s *Server) AddStore(app model.App, st model.Store) model.Store {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st.ID == "" {
+		st.ID = s.newID("store")
+	}
+	s.app(app).stores[st.Name] = &st
+
+	return st
+}
+
+// AddRelease adds a release to the app, a missing ID is generated.
+func (s *Server) AddRelease(app model.App, r model.Release) model.Release {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.ID == 0 {
+		r.ID = s.nextID
+	}
+	if r.ID >= s.nextID {
+		s.nextID = r.ID + 1
+	}
+	if r.AppName == "" {
+		r.AppName = app.AppName
+	}
+	s.app(app).releases[r.ID] = &r
+
+	return r
+}
+
+// Release returns a copy of the release with the given ID.
+func (s *Server) Release(app model.App, releaseID int) (model.Release, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.app(app).releases[releaseID]
+	if !ok {
+		return model.Release{}, false
+	}
+
+	return *r, true
+}
+
+// Testers returns the emails of the testers the release was distributed to.
+func (s *Server) Testers(app model.App, releaseID int) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.app(app).testers[releaseID]...)
+}
+
+// SymbolUploads returns the symbol uploads of the app.
+func (s *Server) SymbolUploads(app model.App) []SymbolUpload {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var uploads []SymbolUpload
+	for _, u := range s.app(app).symbolUploads {
+		uploads = append(uploads, *u)
+	}
+
+	return uploads
+}
+
+// UploadedContent returns the assembled content of the upload which created the release.
+func (s *Server) UploadedContent(releaseID int) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.uploads {
+		if u.releaseID == releaseID && u.finished {
+			return append([]byte(nil), u.content...), true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) app(app model.App) *appState {
+	key := app.Owner + "/" + app.AppName
+	a, ok := s.apps[key]
+	if !ok {
+		a = &appState{
+			releases:      map[int]*model.Release{},
+			groups:        map[string]*model.Group{},
+			members:       map[string][]model.GroupMember{},
+			stores:        map[string]*model.Store{},
+			testers:       map[int][]string{},
+			storePolls:    map[string]int{},
+			symbolUploads: map[string]*SymbolUpload{},
+		}
+		s.apps[key] = a
+	}
+
+	return a
+}
+
+func (s *Server) org(name string) *orgState {
+	o, ok := s.orgs[name]
+	if !ok {
+		o = &orgState{
+			groups:    map[string]*model.Group{},
+			groupApps: map[string][]string{},
+		}
+		s.orgs[name] = o
+	}
+
+	return o
+}
+
+// distributionGroup returns the app group, or the shared group of the owner organization the app is attached to, with the given ID.
+func (s *Server) distributionGroup(app model.App, id string) *model.Group {
+	for _, g := range s.app(app).groups {
+		if g.ID == id {
+			return g
+		}
+	}
+
+	o := s.org(app.Owner)
+	for _, g := range o.groups {
+		if g.ID == id && containsFold(o.groupApps[g.ID], app.AppName) {
+			return g
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) newID(prefix string) string {
+	id := s.nextObjectID
+	s.nextObjectID++
+
+	return prefix + "-" + strconv.Itoa(id)
+}
+
+// takeFault returns the status code of the first matching fault and consumes it.
+func (s *Server) takeFault(r *http.Request) int {
+	for i, f := range s.faults {
+		if f.method != "" && f.method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.pathPrefix) {
+			continue
+		}
+
+		f.remaining--
+		if f.remaining <= 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+
+		return f.statusCode
+	}
+
+	return 0
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(statusCode)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]string{"code": code, "message": message})
+}
@@ -0,0 +1,136 @@
+package appcentertest_test
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/appcenter"
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+var testApp = model.App{Owner: "owner", AppName: "app", AppType: model.AppTypeAndroid}
+
+func newAPI(server *appcentertest.Server) client.API {
+	return client.New(appcentertest.Token,
+		client.WithBaseURL(server.URL),
+		client.WithPollInterval(time.Millisecond),
+		client.WithRetryMax(0),
+		client.WithLogger(client.NopLogger()))
+}
+
+func writeArtifact(t *testing.T, content []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "app-release.apk")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestDeployFlow(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.SetChunkSize(4)
+	server.SetProcessingPolls(2)
+	server.SetChunkDelay(time.Millisecond)
+	server.AddGroup(testApp, model.Group{Name: "QA"})
+	store := server.AddStore(testApp, model.Store{Name: "Production", Type: "googleplay", Track: "production"})
+
+	content := []byte("fake android package content")
+	opts := model.ReleaseOptions{App: testApp, FilePath: writeArtifact(t, content)}
+
+	app := appcenter.CreateApplicationAPI(newAPI(server), opts)
+	release, err := app.NewRelease()
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	uploaded, ok := server.UploadedContent(release.ID)
+	if !ok || !bytes.Equal(uploaded, content) {
+		t.Fatalf("Uploaded content expected to match the artifact, got: %q", uploaded)
+	}
+
+	r := appcenter.CreateReleaseAPI(app.API, release, opts)
+	if err := r.AddGroupsToRelease([]string{"QA"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.AddStore(store); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.AddTester("tester@example.com"); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.SetReleaseNote("notes"); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.UploadSymbol(writeArtifact(t, []byte("mapping"))); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	got, _ := server.Release(testApp, release.ID)
+	if len(got.DistributionGroups) != 1 || len(got.DistributionStores) != 1 || got.ReleaseNotes != "notes" {
+		t.Fatalf("Distributed release expected, got: %+v", got)
+	}
+	if testers := server.Testers(testApp, release.ID); len(testers) != 1 {
+		t.Fatalf("1 tester expected, got: %v", testers)
+	}
+	if symbols := server.SymbolUploads(testApp); len(symbols) != 1 || symbols[0].Status != "committed" || symbols[0].Size != 7 {
+		t.Fatalf("Committed symbol upload expected, got: %+v", symbols)
+	}
+}
+
+func TestDeployFlowFaults(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.SetChunkSize(4)
+	opts := model.ReleaseOptions{App: testApp, FilePath: writeArtifact(t, []byte("fake android package content"))}
+	api := newAPI(server)
+
+	// server errors of the chunks are retried by the http client
+	retryingAPI := client.New(appcentertest.Token,
+		client.WithBaseURL(server.URL),
+		client.WithPollInterval(time.Millisecond),
+		client.WithRetryMax(1),
+		client.WithLogger(client.NopLogger()))
+	server.FailRequests(http.MethodPost, "/upload/upload_chunk/", http.StatusInternalServerError, 1)
+	if _, err := retryingAPI.CreateRelease(opts); err != nil {
+		t.Fatalf("Failed chunk expected to be retried, got: %v", err)
+	}
+
+	server.SetUploadStatus("malwareDetected")
+	if _, err := api.CreateRelease(opts); err == nil || !strings.Contains(err.Error(), "malwareDetected") {
+		t.Fatalf("Malware detected error expected, got: %v", err)
+	}
+
+	server.FailRequests(http.MethodPost, "/v0.1/apps/owner/app/uploads/releases", http.StatusServiceUnavailable, 1)
+	if _, err := api.CreateRelease(opts); client.IsNotFound(err) || err == nil {
+		t.Fatalf("Service unavailable error expected, got: %v", err)
+	}
+}
+
+func TestEscapedPathSegments(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	group := server.AddGroup(testApp, model.Group{Name: "feature/login"})
+	server.AddRelease(testApp, model.Release{ID: 1, DistributionGroups: []model.ReleaseDistributionGroup{{ID: group.ID, Name: group.Name}}})
+
+	releases, err := newAPI(server).ListReleases(testApp, model.ReleaseListOptions{GroupName: "feature/login"})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if len(releases) != 1 || releases[0].ID != 1 {
+		t.Fatalf("Release 1 expected, got: %+v", releases)
+	}
+}
@@ -2,16 +2,16 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net/http"
 	"net/url"
+	"os"
 	"path/filepath"
 	"strconv"
 	"time"
 
-	"golang.org/x/sync/semaphore"
-
 	"github.com/bitrise-io/appcenter/model"
 	"github.com/bitrise-io/appcenter/util"
 )
@@ -19,6 +19,7 @@ import (
 const (
 	maxAttempts               = 100
 	maxConcurrentChunkUploads = 10
+	maxChunkAttempts          = 3
 	releaseFailedID           = -1
 )
 
@@ -32,33 +33,68 @@ type fileAssetResponse struct {
 
 // API ...
 type API struct {
-	Client  Client
-	baseURL string
+	Client Client
+	// ProgressReporter is notified about the progress of the uploads, if set.
+	ProgressReporter ProgressReporter
+	baseURL          string
+	logger           Logger
+	concurrency      int
+	pollIntervalMin  time.Duration
+	pollIntervalMax  time.Duration
+	maxPollAttempts  int
 }
 
-// CreateAPIWithClientParams ...
-func CreateAPIWithClientParams(token string) API {
+// New returns an API authenticated with the given token and configured by the options, for example:
+//
+//	client.New(token, client.WithBaseURL(proxyURL), client.WithConcurrency(4))
+func New(token string, opts ...Option) API {
+	cfg := newConfig(opts)
+
 	return API{
-		Client:  NewClient(token),
-		baseURL: baseURL,
+		Client:           NewClient(token, opts...),
+		ProgressReporter: cfg.progressReporter,
+		baseURL:          cfg.baseURL,
+		logger:           cfg.logger,
+		concurrency:      cfg.concurrency,
+		pollIntervalMin:  cfg.pollIntervalMin,
+		pollIntervalMax:  cfg.pollIntervalMax,
+		maxPollAttempts:  cfg.maxPollAttempts,
 	}
 }
 
+// CreateAPIWithClientParams ...
+func CreateAPIWithClientParams(token string, opts ...Option) API {
+	return New(token, opts...)
+}
+
+func (api API) log() Logger {
+	if api.logger == nil {
+		return NewDefaultLogger()
+	}
+
+	return api.logger
+}
+
 // GetAppReleaseDetails ...
 func (api API) GetAppReleaseDetails(app model.App, releaseID int) (model.Release, error) {
+	return api.GetAppReleaseDetailsContext(context.Background(), app, releaseID)
+}
+
+// GetAppReleaseDetailsContext ...
+func (api API) GetAppReleaseDetailsContext(ctx context.Context, app model.App, releaseID int) (model.Release, error) {
 	//fetch releases and find the latest
 	var (
 		releaseShowURL = fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%s", api.baseURL, app.Owner, app.AppName, strconv.Itoa(releaseID))
 		release        model.Release
 	)
 
-	statusCode, err := api.Client.jsonRequest(http.MethodGet, releaseShowURL, nil, &release)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, releaseShowURL, nil, &release)
 	if err != nil {
 		return model.Release{}, err
 	}
 
 	if statusCode != http.StatusOK {
-		return model.Release{}, fmt.Errorf("invalid status code: %d, url: %s, body: %v", statusCode, releaseShowURL, release)
+		return model.Release{}, newAPIError(http.MethodGet, releaseShowURL, statusCode)
 	}
 
 	return release, err
@@ -66,18 +102,23 @@ func (api API) GetAppReleaseDetails(app model.App, releaseID int) (model.Release
 
 // GetGroupByName ...
 func (api API) GetGroupByName(groupName string, app model.App) (model.Group, error) {
+	return api.GetGroupByNameContext(context.Background(), groupName, app)
+}
+
+// GetGroupByNameContext ...
+func (api API) GetGroupByNameContext(ctx context.Context, groupName string, app model.App) (model.Group, error) {
 	var (
-		getURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s", api.baseURL, app.Owner, app.AppName, groupName)
+		getURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s", api.baseURL, app.Owner, app.AppName, url.PathEscape(groupName))
 		getResponse model.Group
 	)
 
-	statusCode, err := api.Client.jsonRequest(http.MethodGet, getURL, nil, &getResponse)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
 	if err != nil {
 		return model.Group{}, err
 	}
 
 	if statusCode != http.StatusOK {
-		return model.Group{}, fmt.Errorf("invalid status code: %d, url: %s, body: %v", statusCode, getURL, getResponse)
+		return model.Group{}, newAPIError(http.MethodGet, getURL, statusCode)
 	}
 
 	return getResponse, err
@@ -85,18 +126,23 @@ func (api API) GetGroupByName(groupName string, app model.App) (model.Group, err
 
 // GetAllGroups ...
 func (api API) GetAllGroups(app model.App) ([]model.Group, error) {
+	return api.GetAllGroupsContext(context.Background(), app)
+}
+
+// GetAllGroupsContext ...
+func (api API) GetAllGroupsContext(ctx context.Context, app model.App) ([]model.Group, error) {
 	var (
 		getURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups", api.baseURL, app.Owner, app.AppName)
 		getResponse []model.Group
 	)
 
-	statusCode, err := api.Client.jsonRequest(http.MethodGet, getURL, nil, &getResponse)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
 	if err != nil {
 		return []model.Group{}, err
 	}
 
 	if statusCode != http.StatusOK {
-		return []model.Group{}, fmt.Errorf("invalid status code: %d, url: %s, body: %v", statusCode, getURL, getResponse)
+		return []model.Group{}, newAPIError(http.MethodGet, getURL, statusCode)
 	}
 
 	return getResponse, nil
@@ -104,18 +150,23 @@ func (api API) GetAllGroups(app model.App) ([]model.Group, error) {
 
 // GetStore ...
 func (api API) GetStore(storeName string, app model.App) (model.Store, error) {
+	return api.GetStoreContext(context.Background(), storeName, app)
+}
+
+// GetStoreContext ...
+func (api API) GetStoreContext(ctx context.Context, storeName string, app model.App) (model.Store, error) {
 	var (
-		getURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_stores/%s", api.baseURL, app.Owner, app.AppName, storeName)
+		getURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_stores/%s", api.baseURL, app.Owner, app.AppName, url.PathEscape(storeName))
 		getResponse model.Store
 	)
 
-	statusCode, err := api.Client.jsonRequest(http.MethodGet, getURL, nil, &getResponse)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
 	if err != nil {
 		return model.Store{}, err
 	}
 
 	if statusCode != http.StatusOK {
-		return model.Store{}, fmt.Errorf("invalid status code: %d, url: %s, body: %v", statusCode, getURL, getResponse)
+		return model.Store{}, newAPIError(http.MethodGet, getURL, statusCode)
 	}
 
 	return getResponse, nil
@@ -123,6 +174,11 @@ func (api API) GetStore(storeName string, app model.App) (model.Store, error) {
 
 // AddReleaseToGroup ...
 func (api API) AddReleaseToGroup(g model.Group, releaseID int, opts model.ReleaseOptions) error {
+	return api.AddReleaseToGroupContext(context.Background(), g, releaseID, opts)
+}
+
+// AddReleaseToGroupContext ...
+func (api API) AddReleaseToGroupContext(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error {
 	var (
 		postURL     = fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d/groups", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID)
 		postRequest = struct {
@@ -141,13 +197,13 @@ func (api API) AddReleaseToGroup(g model.Group, releaseID int, opts model.Releas
 		return err
 	}
 
-	statusCode, err := api.Client.jsonRequest(http.MethodPost, postURL, body, nil)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, nil)
 	if err != nil {
 		return err
 	}
 
 	if statusCode != http.StatusCreated {
-		return fmt.Errorf("invalid status code: %d, url: %s", statusCode, postURL)
+		return newAPIError(http.MethodPost, postURL, statusCode)
 	}
 
 	return nil
@@ -155,6 +211,11 @@ func (api API) AddReleaseToGroup(g model.Group, releaseID int, opts model.Releas
 
 // AddReleaseToStore ...
 func (api API) AddReleaseToStore(s model.Store, releaseID int, opts model.ReleaseOptions) error {
+	return api.AddReleaseToStoreContext(context.Background(), s, releaseID, opts)
+}
+
+// AddReleaseToStoreContext ...
+func (api API) AddReleaseToStoreContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error {
 	var (
 		postURL     = fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d/stores", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID)
 		postRequest = struct {
@@ -169,13 +230,13 @@ func (api API) AddReleaseToStore(s model.Store, releaseID int, opts model.Releas
 		return err
 	}
 
-	statusCode, err := api.Client.jsonRequest(http.MethodPost, postURL, body, nil)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, nil)
 	if err != nil {
 		return err
 	}
 
 	if statusCode != http.StatusCreated {
-		return fmt.Errorf("invalid status code: %d, url: %s", statusCode, postURL)
+		return newAPIError(http.MethodPost, postURL, statusCode)
 	}
 
 	return nil
@@ -183,6 +244,11 @@ func (api API) AddReleaseToStore(s model.Store, releaseID int, opts model.Releas
 
 // AddTesterToRelease ...
 func (api API) AddTesterToRelease(email string, releaseID int, opts model.ReleaseOptions) error {
+	return api.AddTesterToReleaseContext(context.Background(), email, releaseID, opts)
+}
+
+// AddTesterToReleaseContext ...
+func (api API) AddTesterToReleaseContext(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error {
 	var (
 		postURL     = fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d/testers", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID)
 		postRequest = struct {
@@ -201,13 +267,13 @@ func (api API) AddTesterToRelease(email string, releaseID int, opts model.Releas
 		return err
 	}
 
-	statusCode, err := api.Client.jsonRequest(http.MethodPost, postURL, body, nil)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, nil)
 	if err != nil {
 		return err
 	}
 
 	if statusCode != http.StatusCreated {
-		return fmt.Errorf("invalid status code: %d, url: %s", statusCode, postURL)
+		return newAPIError(http.MethodPost, postURL, statusCode)
 	}
 
 	return nil
@@ -215,6 +281,11 @@ func (api API) AddTesterToRelease(email string, releaseID int, opts model.Releas
 
 // SetReleaseNoteOnRelease ...
 func (api API) SetReleaseNoteOnRelease(releaseNote string, releaseID int, opts model.ReleaseOptions) error {
+	return api.SetReleaseNoteOnReleaseContext(context.Background(), releaseNote, releaseID, opts)
+}
+
+// SetReleaseNoteOnReleaseContext ...
+func (api API) SetReleaseNoteOnReleaseContext(ctx context.Context, releaseNote string, releaseID int, opts model.ReleaseOptions) error {
 	var (
 		putURL     = fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID)
 		putRequest = struct {
@@ -229,13 +300,70 @@ func (api API) SetReleaseNoteOnRelease(releaseNote string, releaseID int, opts m
 		return err
 	}
 
-	statusCode, err := api.Client.jsonRequest(http.MethodPut, putURL, body, nil)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPut, putURL, body, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK {
+		return newAPIError(http.MethodPut, putURL, statusCode)
+	}
+
+	return nil
+}
+
+// UpdateRelease ...
+func (api API) UpdateRelease(update model.ReleaseUpdate, releaseID int, opts model.ReleaseOptions) error {
+	return api.UpdateReleaseContext(context.Background(), update, releaseID, opts)
+}
+
+// UpdateReleaseContext changes the set fields of the update: the release notes and the build
+// information are updated with a PUT, enabling or disabling the release with a PATCH request.
+func (api API) UpdateReleaseContext(ctx context.Context, update model.ReleaseUpdate, releaseID int, opts model.ReleaseOptions) error {
+	releaseURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID)
+
+	if update.ReleaseNotes != nil || update.Build != nil {
+		putRequest := struct {
+			ReleaseNotes *string             `json:"release_notes,omitempty"`
+			Build        *model.ReleaseBuild `json:"build,omitempty"`
+		}{
+			ReleaseNotes: update.ReleaseNotes,
+			Build:        update.Build,
+		}
+
+		if err := api.sendReleaseUpdate(ctx, http.MethodPut, releaseURL, putRequest); err != nil {
+			return err
+		}
+	}
+
+	if update.Enabled != nil {
+		patchRequest := struct {
+			Enabled bool `json:"enabled"`
+		}{
+			Enabled: *update.Enabled,
+		}
+
+		if err := api.sendReleaseUpdate(ctx, http.MethodPatch, releaseURL, patchRequest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (api API) sendReleaseUpdate(ctx context.Context, method, releaseURL string, request interface{}) error {
+	body, err := api.Client.MarshallContent(request)
+	if err != nil {
+		return err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, method, releaseURL, body, nil)
 	if err != nil {
 		return err
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("invalid status code: %d, url: %s", statusCode, putURL)
+		return newAPIError(method, releaseURL, statusCode)
 	}
 
 	return nil
@@ -243,11 +371,24 @@ func (api API) SetReleaseNoteOnRelease(releaseNote string, releaseID int, opts m
 
 // UploadSymbolToRelease - build and version is required for Android and optional for iOS
 func (api API) UploadSymbolToRelease(filePath string, release model.Release, opts model.ReleaseOptions) error {
+	return api.UploadSymbolToReleaseContext(context.Background(), filePath, release, opts)
+}
+
+// UploadSymbolToReleaseContext - build and version is required for Android and optional for iOS
+func (api API) UploadSymbolToReleaseContext(ctx context.Context, filePath string, release model.Release, opts model.ReleaseOptions) error {
 	var symbolType = model.SymbolTypeDSYM
 	if release.AppOs == "Android" {
 		symbolType = model.SymbolTypeMapping
 	}
 
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+
+	progress := Progress{Phase: PhaseMetadata, TotalBytes: info.Size(), ChunksTotal: 1}
+	api.reportProgress(progress)
+
 	// send file upload request
 	var (
 		postURL  = fmt.Sprintf("%s/v0.1/apps/%s/%s/symbol_uploads", api.baseURL, opts.App.Owner, opts.App.AppName)
@@ -274,25 +415,33 @@ func (api API) UploadSymbolToRelease(filePath string, release model.Release, opt
 		return err
 	}
 
-	statusCode, err := api.Client.jsonRequest(http.MethodPost, postURL, body, &postResponse)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, &postResponse)
 	if err != nil {
 		return err
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("invalid status code: %d, url: %s, body: %v", statusCode, postURL, postBody)
+		return newAPIError(http.MethodPost, postURL, statusCode)
 	}
 
 	// upload file to {upload_url}
-	statusCode, err = api.Client.uploadFile(postResponse.UploadURL, filePath)
+	statusCode, err = api.Client.uploadFile(ctx, postResponse.UploadURL, filePath)
 	if err != nil {
 		return err
 	}
 
 	if statusCode != http.StatusCreated {
-		return fmt.Errorf("invalid status code: %d, url: %s", statusCode, postResponse.UploadURL)
+		return newAPIError(http.MethodPut, postResponse.UploadURL, statusCode)
 	}
 
+	progress.Phase = PhaseChunks
+	progress.BytesSent = info.Size()
+	progress.ChunksDone = 1
+	api.reportProgress(progress)
+
+	progress.Phase = PhaseFinishing
+	api.reportProgress(progress)
+
 	var (
 		patchURL  = fmt.Sprintf("%s/v0.1/apps/%s/%s/symbol_uploads/%s", api.baseURL, opts.App.Owner, opts.App.AppName, postResponse.SymbolUploadID)
 		patchBody = map[string]string{
@@ -305,20 +454,86 @@ func (api API) UploadSymbolToRelease(filePath string, release model.Release, opt
 		return err
 	}
 
-	statusCode, err = api.Client.jsonRequest(http.MethodPatch, patchURL, body, nil)
+	statusCode, err = api.Client.jsonRequest(ctx, http.MethodPatch, patchURL, body, nil)
 	if err != nil {
 		return err
 	}
 
 	if statusCode != http.StatusOK {
-		return fmt.Errorf("invalid status code: %d, url: %s", statusCode, patchURL)
+		return newAPIError(http.MethodPatch, patchURL, statusCode)
 	}
 
+	progress.Phase = PhaseDone
+	api.reportProgress(progress)
+
 	return nil
 }
 
 // CreateRelease ...
 func (api API) CreateRelease(opts model.ReleaseOptions) (int, error) {
+	return api.CreateReleaseContext(context.Background(), opts)
+}
+
+// CreateReleaseContext is like CreateRelease, but cancelling ctx aborts the
+// in-flight chunk uploads and the release status polling.
+//
+// If opts.UploadSessionPath is set, the upload session is persisted to that file
+// and an interrupted upload can be continued with ResumeRelease.
+func (api API) CreateReleaseContext(ctx context.Context, opts model.ReleaseOptions) (int, error) {
+	result, err := api.CreateReleaseWithResultContext(ctx, opts)
+	if err != nil {
+		return releaseFailedID, err
+	}
+
+	return result.ReleaseID, nil
+}
+
+// CreateReleaseWithResult ...
+func (api API) CreateReleaseWithResult(opts model.ReleaseOptions) (model.CreateReleaseResult, error) {
+	return api.CreateReleaseWithResultContext(context.Background(), opts)
+}
+
+// CreateReleaseWithResultContext is like CreateReleaseContext, but the result also tells
+// whether an existing release was reused because of opts.ReuseExistingRelease.
+func (api API) CreateReleaseWithResultContext(ctx context.Context, opts model.ReleaseOptions) (model.CreateReleaseResult, error) {
+	if !opts.ReuseExistingRelease {
+		releaseID, err := api.uploadRelease(ctx, opts, "")
+		return model.CreateReleaseResult{ReleaseID: releaseID}, err
+	}
+
+	file := util.LocalFile{FilePath: opts.FilePath}
+	if err := file.OpenFile(); err != nil {
+		return model.CreateReleaseResult{ReleaseID: releaseFailedID}, err
+	}
+
+	hash, err := file.SHA256()
+	if cerr := file.Close(); cerr != nil {
+		api.log().Warn("failed to close file", F("path", file.FilePath), F("error", cerr))
+	}
+	if err != nil {
+		return model.CreateReleaseResult{ReleaseID: releaseFailedID}, err
+	}
+
+	release, err := api.FindReleaseByHashContext(ctx, opts.App, hash, opts.ReuseLookupReleases)
+	switch {
+	case err == nil:
+		api.log().Info("Release with the same package hash already exists, skipping the upload",
+			F("release_id", release.ID),
+			F("package_hash", hash))
+
+		return model.CreateReleaseResult{ReleaseID: release.ID, Reused: true, PackageHash: hash}, nil
+	case !errors.Is(err, ErrReleaseNotFound):
+		return model.CreateReleaseResult{ReleaseID: releaseFailedID, PackageHash: hash}, err
+	}
+
+	releaseID, err := api.uploadRelease(ctx, opts, hash)
+
+	return model.CreateReleaseResult{ReleaseID: releaseID, PackageHash: hash}, err
+}
+
+// uploadRelease uploads opts.FilePath as a new release. fileHash is the SHA-256 hash of the file if it is already known,
+// otherwise it is computed when the upload session is persisted.
+func (api API) uploadRelease(ctx context.Context, opts model.ReleaseOptions, fileHash string) (int, error) {
 	var (
 		assetsURL = fmt.Sprintf("%s/v0.1/apps/%s/%s/uploads/releases",
 			api.baseURL,
@@ -327,18 +542,18 @@ func (api API) CreateRelease(opts model.ReleaseOptions) (int, error) {
 		assetResponse fileAssetResponse
 	)
 
-	statusCode, err := api.Client.jsonRequest(http.MethodPost, assetsURL, nil, &assetResponse)
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, assetsURL, nil, &assetResponse)
 	if err != nil {
 		return releaseFailedID, err
 	}
 
 	if statusCode != http.StatusCreated {
-		return releaseFailedID, fmt.Errorf("invalid status code: %d, url: %s", statusCode, assetsURL)
+		return releaseFailedID, newAPIError(http.MethodPost, assetsURL, statusCode)
 	}
 
-	fmt.Println("")
-	fmt.Println(fmt.Sprintf("Asset release ID: %s", assetResponse.ReleaseID))
-	fmt.Println(fmt.Sprintf("File asset ID: %s", assetResponse.PackageAssetID))
+	api.log().Info("Release upload created",
+		F("upload_id", assetResponse.ReleaseID),
+		F("package_asset_id", assetResponse.PackageAssetID))
 
 	file := util.LocalFile{FilePath: opts.FilePath}
 	err = file.OpenFile()
@@ -346,20 +561,34 @@ func (api API) CreateRelease(opts model.ReleaseOptions) (int, error) {
 		return releaseFailedID, err
 	}
 
+	defer func() {
+		if err := file.Close(); err != nil {
+			api.log().Warn("failed to close file", F("path", file.FilePath), F("error", err))
+		}
+	}()
+
 	fileName := file.FileName()
 	fileSize := file.FileSize()
 
-	fmt.Println("")
-	fmt.Println("Uploading file with metadata:")
-	fmt.Println(fmt.Sprintf("- File name: %s", fileName))
-	fmt.Println(fmt.Sprintf("- File size: %s", strconv.Itoa(fileSize)))
+	// the hash is only needed to check the file when the upload is resumed
+	if fileHash == "" && opts.UploadSessionPath != "" {
+		if fileHash, err = file.SHA256(); err != nil {
+			return releaseFailedID, fmt.Errorf("failed to hash file: %w", err)
+		}
+	}
+
+	api.log().Info("Uploading file with metadata",
+		F("file_name", fileName),
+		F("file_size", fileSize))
+
+	api.reportProgress(Progress{Phase: PhaseMetadata, TotalBytes: fileSize})
 
 	var (
 		metadataURL = fmt.Sprintf("%s/upload/set_metadata/%s?file_name=%s&file_size=%s&token=%s&content_type=%s",
 			assetResponse.UploadDomain,
 			assetResponse.PackageAssetID,
 			url.QueryEscape(fileName),
-			strconv.Itoa(fileSize),
+			strconv.FormatInt(fileSize, 10),
 			assetResponse.URLEncodedToken,
 			getContentType(opts.App.AppType))
 		metadataResponse struct {
@@ -370,102 +599,225 @@ func (api API) CreateRelease(opts model.ReleaseOptions) (int, error) {
 		}
 	)
 
-	statusCode, err = api.Client.jsonRequest(http.MethodPost, metadataURL, nil, &metadataResponse)
+	statusCode, err = api.Client.jsonRequest(ctx, http.MethodPost, metadataURL, nil, &metadataResponse)
 	if err != nil {
 		return releaseFailedID, err
 	}
 
 	if statusCode != http.StatusOK {
-		return releaseFailedID, fmt.Errorf("invalid status code: %d, url: %s", statusCode, metadataURL)
+		return releaseFailedID, newAPIError(http.MethodPost, metadataURL, statusCode)
+	}
+
+	session := newUploadSession(opts.UploadSessionPath, model.UploadSession{
+		UploadID:        assetResponse.ReleaseID,
+		PackageAssetID:  assetResponse.PackageAssetID,
+		Token:           assetResponse.Token,
+		UploadDomain:    assetResponse.UploadDomain,
+		URLEncodedToken: assetResponse.URLEncodedToken,
+		FilePath:        opts.FilePath,
+		FileSize:        fileSize,
+		FileSHA256:      fileHash,
+		ChunkSize:       metadataResponse.ChunkSize,
+		ChunkList:       metadataResponse.ChunkList,
+	})
+	if err := validateUploadSession(session.UploadSession); err != nil {
+		return releaseFailedID, fmt.Errorf("invalid upload metadata response: %w", err)
+	}
+	if err := session.save(); err != nil {
+		return releaseFailedID, err
 	}
 
-	fmt.Println("")
-	fmt.Println("Upload information:")
-	fmt.Println(fmt.Sprintf("Chunk size: %d bytes", metadataResponse.ChunkSize))
-	fmt.Println(fmt.Sprintf("Chunk number: %d", len(metadataResponse.ChunkList)))
+	api.log().Info("Upload information",
+		F("upload_id", session.UploadID),
+		F("chunk_size", metadataResponse.ChunkSize),
+		F("chunk_count", len(metadataResponse.ChunkList)))
 
-	fmt.Println("")
-	fmt.Println("Uploading chunks ...")
+	return api.finishRelease(ctx, opts, file, session)
+}
 
-	fileChunks := file.MakeChunks(metadataResponse.ChunkSize)
+// ResumeRelease ...
+func (api API) ResumeRelease(opts model.ReleaseOptions) (int, error) {
+	return api.ResumeReleaseContext(context.Background(), opts)
+}
 
-	err = api.uploadChunksInParallel(fileChunks, metadataResponse.ChunkList, assetResponse)
+// ResumeReleaseContext continues the upload session persisted at opts.UploadSessionPath
+// by an interrupted CreateRelease: only the missing chunks are uploaded,
+// then the upload is finished and the release is waited for as usual.
+func (api API) ResumeReleaseContext(ctx context.Context, opts model.ReleaseOptions) (int, error) {
+	session, err := loadUploadSession(opts.UploadSessionPath)
 	if err != nil {
 		return releaseFailedID, err
 	}
 
-	fmt.Println("")
-	fmt.Println("Chunk upload finished...")
-
-	var (
-		uploadFinishedURL = fmt.Sprintf("%s/upload/finished/%s?token=%s",
-			assetResponse.UploadDomain,
-			assetResponse.PackageAssetID,
-			assetResponse.URLEncodedToken)
-		finishedResponse interface{}
-	)
+	if opts.FilePath != "" && opts.FilePath != session.FilePath {
+		return releaseFailedID, fmt.Errorf("upload session belongs to a different file: %s", session.FilePath)
+	}
 
-	statusCode, err = api.Client.jsonRequest(http.MethodPost, uploadFinishedURL, nil, &finishedResponse)
+	file := util.LocalFile{FilePath: session.FilePath}
+	err = file.OpenFile()
 	if err != nil {
 		return releaseFailedID, err
 	}
 
-	if statusCode != http.StatusOK {
-		return releaseFailedID, fmt.Errorf("invalid status code: %d, url: %s", statusCode, uploadFinishedURL)
+	defer func() {
+		if err := file.Close(); err != nil {
+			api.log().Warn("failed to close file", F("path", file.FilePath), F("error", err))
+		}
+	}()
+
+	if file.FileSize() != session.FileSize {
+		return releaseFailedID, fmt.Errorf("file size changed since the upload was started, expected: %d, actual: %d", session.FileSize, file.FileSize())
+	}
+
+	if session.FileSHA256 == "" {
+		api.log().Warn("upload session has no file hash, the file content can not be checked", F("path", session.path))
+	} else {
+		hash, err := file.SHA256()
+		if err != nil {
+			return releaseFailedID, fmt.Errorf("failed to hash file: %w", err)
+		}
+		if hash != session.FileSHA256 {
+			return releaseFailedID, fmt.Errorf("file content changed since the upload was started: %s", session.FilePath)
+		}
 	}
 
-	fmt.Println("")
-	fmt.Println("Upload finished...")
+	api.log().Info("Resuming release upload",
+		F("upload_id", session.UploadID),
+		F("uploaded_chunks", len(session.UploadedChunks)),
+		F("chunk_count", len(session.ChunkList)))
 
-	//patch release
+	return api.finishRelease(ctx, opts, file, session)
+}
 
-	var (
-		releasePatchURL = fmt.Sprintf("%s/v0.1/apps/%s/%s/uploads/releases/%s",
-			api.baseURL,
-			opts.App.Owner,
-			opts.App.AppName,
-			assetResponse.ReleaseID)
-		releaseBody = struct {
-			UploadStatus string `json:"upload_status"`
-		}{
-			UploadStatus: "uploadFinished",
+// finishRelease uploads the chunks which are not uploaded yet in the session,
+// finishes the upload, patches the release and waits for it to be ready.
+func (api API) finishRelease(ctx context.Context, opts model.ReleaseOptions, file util.LocalFile, session *uploadSession) (int, error) {
+	if !session.UploadFinished {
+		api.log().Info("Uploading chunks", F("upload_id", session.UploadID))
+
+		allChunks, err := file.MakeChunks(session.ChunkSize)
+		if err != nil {
+			return releaseFailedID, err
 		}
-		releasePatchResponse interface{}
-	)
+		fileChunks, chunkIDs := session.missingChunks(allChunks)
+
+		progress := &chunkProgress{
+			api: api,
+			progress: Progress{
+				Phase:       PhaseChunks,
+				BytesSent:   session.FileSize,
+				TotalBytes:  session.FileSize,
+				ChunksDone:  len(session.ChunkList) - len(chunkIDs),
+				ChunksTotal: len(session.ChunkList),
+			},
+		}
+		for _, chunk := range fileChunks {
+			progress.progress.BytesSent -= chunk.Size()
+		}
+		api.reportProgress(progress.progress)
 
-	body, err := api.Client.MarshallContent(releaseBody)
-	if err != nil {
-		return releaseFailedID, err
-	}
+		if err := api.uploadChunksInParallel(ctx, fileChunks, chunkIDs, session, progress); err != nil {
+			return releaseFailedID, err
+		}
 
-	statusCode, err = api.Client.jsonRequest(http.MethodPatch, releasePatchURL, body, &releasePatchResponse)
-	if err != nil {
-		return releaseFailedID, err
-	}
+		api.log().Info("Chunk upload finished", F("upload_id", session.UploadID))
 
-	if statusCode != http.StatusOK {
-		return releaseFailedID, fmt.Errorf("invalid status code: %d, url: %s", statusCode, releasePatchURL)
+		api.reportProgress(Progress{
+			Phase:       PhaseFinishing,
+			BytesSent:   session.FileSize,
+			TotalBytes:  session.FileSize,
+			ChunksDone:  len(session.ChunkList),
+			ChunksTotal: len(session.ChunkList),
+		})
+
+		var (
+			uploadFinishedURL = fmt.Sprintf("%s/upload/finished/%s?token=%s",
+				session.UploadDomain,
+				session.PackageAssetID,
+				session.URLEncodedToken)
+			finishedResponse interface{}
+		)
+
+		statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, uploadFinishedURL, nil, &finishedResponse)
+		if err != nil {
+			return releaseFailedID, err
+		}
+
+		if statusCode != http.StatusOK {
+			return releaseFailedID, newAPIError(http.MethodPost, uploadFinishedURL, statusCode)
+		}
+
+		session.UploadFinished = true
+		if err := session.save(); err != nil {
+			return releaseFailedID, err
+		}
+
+		api.log().Info("Upload finished", F("upload_id", session.UploadID))
 	}
 
-	fmt.Println("")
-	fmt.Println("Release patched...")
+	if !session.ReleasePatched {
+		//patch release
 
-	fmt.Println("")
-	fmt.Println("Waiting for the AppCenter release to getting ready...")
+		var (
+			releasePatchURL = fmt.Sprintf("%s/v0.1/apps/%s/%s/uploads/releases/%s",
+				api.baseURL,
+				opts.App.Owner,
+				opts.App.AppName,
+				session.UploadID)
+			releaseBody = struct {
+				UploadStatus string `json:"upload_status"`
+			}{
+				UploadStatus: "uploadFinished",
+			}
+			releasePatchResponse interface{}
+		)
+
+		body, err := api.Client.MarshallContent(releaseBody)
+		if err != nil {
+			return releaseFailedID, err
+		}
+
+		statusCode, err := api.Client.jsonRequest(ctx, http.MethodPatch, releasePatchURL, body, &releasePatchResponse)
+		if err != nil {
+			return releaseFailedID, err
+		}
+
+		if statusCode != http.StatusOK {
+			return releaseFailedID, newAPIError(http.MethodPatch, releasePatchURL, statusCode)
+		}
+
+		session.ReleasePatched = true
+		if err := session.save(); err != nil {
+			return releaseFailedID, err
+		}
+
+		api.log().Info("Release patched", F("upload_id", session.UploadID))
+	}
+
+	api.log().Info("Waiting for the AppCenter release to getting ready", F("upload_id", session.UploadID))
 
 	uploadStatus := "commited"
 	releaseDistinctID := releaseFailedID
 	attempts := 1
 
-	for !maxAttemptsReached(attempts) {
-		fmt.Println(fmt.Sprintf("Attempt(s): %d", attempts))
+	for !api.maxAttemptsReached(attempts) {
+		api.log().Debug("Checking release status", F("upload_id", session.UploadID), F("attempt", attempts))
+
+		api.reportProgress(Progress{
+			Phase:       PhasePolling,
+			BytesSent:   session.FileSize,
+			TotalBytes:  session.FileSize,
+			ChunksDone:  len(session.ChunkList),
+			ChunksTotal: len(session.ChunkList),
+			PollAttempt: attempts,
+		})
 
 		var (
 			getURL = fmt.Sprintf("%s/v0.1/apps/%s/%s/uploads/releases/%s",
 				api.baseURL,
 				opts.App.Owner,
 				opts.App.AppName,
-				assetResponse.ReleaseID)
+				session.UploadID)
 			getResponse struct {
 				ID                string `json:"id"`
 				ReleaseDistinctID int    `json:"release_distinct_id,omitempty"`
@@ -473,13 +825,13 @@ func (api API) CreateRelease(opts model.ReleaseOptions) (int, error) {
 			}
 		)
 
-		statusCode, err = api.Client.jsonRequest(http.MethodGet, getURL, nil, &getResponse)
+		statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
 		if err != nil {
 			return releaseFailedID, err
 		}
 
 		if statusCode != http.StatusOK {
-			return releaseFailedID, fmt.Errorf("invalid status code: %d, url: %s", statusCode, getURL)
+			return releaseFailedID, newAPIError(http.MethodGet, getURL, statusCode)
 		}
 
 		uploadStatus = getResponse.UploadStatus
@@ -495,15 +847,37 @@ func (api API) CreateRelease(opts model.ReleaseOptions) (int, error) {
 		} else {
 			attempts++
 
-			sleepDuration := generateRandomIntBetweenRange(5, 10)
-			fmt.Println(fmt.Sprintf("Waiting for %d second(s), current status: %s", sleepDuration, uploadStatus))
+			sleepDuration := api.pollInterval()
+			api.log().Info("Waiting for the release",
+				F("upload_id", session.UploadID),
+				F("upload_status", uploadStatus),
+				F("wait", sleepDuration))
 
-			time.Sleep(time.Duration(sleepDuration) * time.Second)
+			select {
+			case <-ctx.Done():
+				return releaseFailedID, ctx.Err()
+			case <-time.After(sleepDuration):
+			}
 		}
 	}
 
-	fmt.Println("")
-	fmt.Println(fmt.Sprintf("Release created with ID: %d", releaseDistinctID))
+	if releaseDistinctID == releaseFailedID {
+		return releaseFailedID, fmt.Errorf("release is not ready after %d attempts, last status: %s", attempts-1, uploadStatus)
+	}
+
+	if err := session.remove(); err != nil {
+		api.log().Warn("failed to remove upload session", F("path", session.path), F("error", err))
+	}
+
+	api.log().Info("Release created", F("release_id", releaseDistinctID))
+
+	api.reportProgress(Progress{
+		Phase:       PhaseDone,
+		BytesSent:   session.FileSize,
+		TotalBytes:  session.FileSize,
+		ChunksDone:  len(session.ChunkList),
+		ChunksTotal: len(session.ChunkList),
+	})
 
 	return releaseDistinctID, nil
 }
@@ -519,67 +893,17 @@ func getContentType(appType model.AppType) string {
 	}
 }
 
-func (api API) uploadChunksInParallel(fileChunks [][]byte, chunkIDs []int, assetResponse fileAssetResponse) (retErr error) {
-	sem := semaphore.NewWeighted(maxConcurrentChunkUploads)
-	ctx := context.Background()
-
-	for idx, chunkID := range chunkIDs {
-		chunk := fileChunks[idx]
-
-		if err := sem.Acquire(ctx, 1); err != nil {
-			return err
-		}
-
-		go func(chunk []byte, ID int) {
-			defer sem.Release(1)
-
-			fmt.Println(fmt.Sprintf("Uploading chunk with ID: %d, size: %d", ID, len(chunk)))
-
-			var (
-				chunkUploadURL = fmt.Sprintf("%s/upload/upload_chunk/%s?block_number=%s&token=%s",
-					assetResponse.UploadDomain,
-					assetResponse.PackageAssetID,
-					strconv.Itoa(ID),
-					assetResponse.URLEncodedToken)
-				chunkUploadResponse struct {
-					Error     bool   `json:"error"`
-					ErrorCode string `json:"error_code"`
-				}
-			)
-
-			statusCode, err := api.Client.jsonRequest(http.MethodPost, chunkUploadURL, chunk, &chunkUploadResponse)
-			if err != nil {
-				retErr = err
-
-				return
-			}
-			if chunkUploadResponse.Error {
-				retErr = fmt.Errorf("failed to upload chunk, chunk id: %d, error code: %s",
-					ID,
-					chunkUploadResponse.ErrorCode)
-				return
-			}
-
-			if statusCode != http.StatusOK {
-				retErr = fmt.Errorf("invalid status code: %d, url: %s", statusCode, chunkUploadURL)
-				return
-			}
-
-			fmt.Println(fmt.Sprintf("Uploading finished, ID: %d", ID))
-		}(chunk, chunkID)
+// pollInterval returns a random duration between the configured minimum and maximum poll interval.
+func (api API) pollInterval() time.Duration {
+	min, max := api.pollIntervalMin, api.pollIntervalMax
+	if min <= 0 || max <= 0 {
+		min, max = defaultPollIntervalMin, defaultPollIntervalMax
 	}
-
-	// Acquire all tokens to wait for all the goroutines to finish.
-	if err := sem.Acquire(ctx, maxConcurrentChunkUploads); err != nil {
-		return err
+	if max <= min {
+		return min
 	}
 
-	return
-}
-
-func generateRandomIntBetweenRange(min, max int) int {
-	rand.Seed(time.Now().UnixNano())
-	return rand.Intn(max-min) + min
+	return min + time.Duration(rand.Int63n(int64(max-min)))
 }
 
 func uploadIsReadyForDeploy(status string) (bool, error) {
@@ -599,6 +923,12 @@ func uploadIsReadyForDeploy(status string) (bool, error) {
 	}
 }
 
-func maxAttemptsReached(current int) bool {
+// maxAttemptsReached keeps the original bound of maxAttempts (99 status requests),
+// while a configured WithMaxPollAttempts is the exact number of status requests.
+func (api API) maxAttemptsReached(current int) bool {
+	if api.maxPollAttempts > 0 {
+		return current > api.maxPollAttempts
+	}
+
 	return current >= maxAttempts
 }
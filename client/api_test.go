@@ -1,9 +1,16 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bitrise-io/appcenter/model"
 )
@@ -41,3 +48,224 @@ func TestCreateAPIWithClientParams(t *testing.T) {
 		t.Fatalf("No error expected, got: %v", err)
 	}
 }
+
+func TestCreateReleaseContextCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(201)
+	}))
+	defer ts.Close()
+
+	api := New("MYTOKEN", WithBaseURL(ts.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := api.CreateReleaseContext(ctx, model.ReleaseOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("context.Canceled expected, got: %v", err)
+	}
+}
+
+func TestResumeReleaseUploadsMissingChunks(t *testing.T) {
+	var uploadedChunks []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/upload/upload_chunk/"):
+			uploadedChunks = append(uploadedChunks, r.URL.Query().Get("block_number"))
+			_, _ = w.Write([]byte(`{"error":false}`))
+		case strings.HasPrefix(r.URL.Path, "/upload/finished/"):
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodPatch:
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"upload_status":"readyToBePublished","release_distinct_id":42}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.apk")
+	if err := os.WriteFile(filePath, []byte("0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	sessionPath := filepath.Join(dir, "session.json")
+	b, err := json.Marshal(model.UploadSession{
+		UploadID:       "upload-id",
+		PackageAssetID: "asset-id",
+		UploadDomain:   ts.URL,
+		FilePath:       filePath,
+		FileSize:       10,
+		FileSHA256:     "84d89877f0d4041efb6bf91a16f0248f2fd573e6af05c19f96bedb9f882f7882",
+		ChunkSize:      5,
+		ChunkList:      []int{1, 2},
+		UploadedChunks: []int{1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(sessionPath, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var reports []Progress
+	api := New("MYTOKEN", WithBaseURL(ts.URL), WithLogger(NopLogger()))
+	api.ProgressReporter = ProgressReporterFunc(func(p Progress) {
+		reports = append(reports, p)
+	})
+
+	releaseID, err := api.ResumeRelease(model.ReleaseOptions{FilePath: filePath, UploadSessionPath: sessionPath})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if releaseID != 42 {
+		t.Fatalf("Release ID 42 expected, got: %d", releaseID)
+	}
+	if len(uploadedChunks) != 1 || uploadedChunks[0] != "2" {
+		t.Fatalf("Only chunk 2 expected to be uploaded, got: %v", uploadedChunks)
+	}
+	if reports[0].Phase != PhaseChunks || reports[0].ChunksDone != 1 || reports[0].BytesSent != 5 {
+		t.Fatalf("Resumed chunk progress expected, got: %+v", reports[0])
+	}
+	if last := reports[len(reports)-1]; last.Phase != PhaseDone || last.ChunksDone != 2 || last.BytesSent != 10 {
+		t.Fatalf("Done progress expected, got: %+v", last)
+	}
+	if _, err := os.Stat(sessionPath); !os.IsNotExist(err) {
+		t.Fatalf("Upload session expected to be removed, got: %v", err)
+	}
+}
+
+func TestResumeReleaseRejectsInvalidSession(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.apk")
+	if err := os.WriteFile(filePath, []byte("0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := model.UploadSession{FilePath: filePath, FileSize: 10, ChunkSize: 5, ChunkList: []int{1, 2}, FileSHA256: "84d89877f0d4041efb6bf91a16f0248f2fd573e6af05c19f96bedb9f882f7882"}
+	zeroChunkSize, shortChunkList, rebuilt := valid, valid, valid
+	zeroChunkSize.ChunkSize = 0
+	shortChunkList.ChunkList = []int{1}
+	rebuilt.FileSHA256 = "0000"
+
+	api := New("MYTOKEN", WithBaseURL("http://127.0.0.1:0"), WithLogger(NopLogger()))
+	for name, session := range map[string]model.UploadSession{"zero chunk size": zeroChunkSize, "short chunk list": shortChunkList, "rebuilt file": rebuilt} {
+		sessionPath := filepath.Join(dir, "session.json")
+		b, err := json.Marshal(session)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(sessionPath, b, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := api.ResumeRelease(model.ReleaseOptions{UploadSessionPath: sessionPath}); err == nil {
+			t.Errorf("%s: error expected", name)
+		}
+	}
+}
+
+func TestCreateReleaseRejectsInvalidChunkList(t *testing.T) {
+	var uploadedChunks int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/uploads/releases"):
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"upload-id","package_asset_id":"asset-id","upload_domain":"` + "http://" + r.Host + `"}`))
+		case strings.HasPrefix(r.URL.Path, "/upload/set_metadata/"):
+			_, _ = w.Write([]byte(`{"chunk_size":5,"chunk_list":[1]}`))
+		case strings.HasPrefix(r.URL.Path, "/upload/upload_chunk/"):
+			uploadedChunks++
+			_, _ = w.Write([]byte(`{"error":false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	filePath := filepath.Join(t.TempDir(), "app.apk")
+	if err := os.WriteFile(filePath, []byte("0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	api := New("MYTOKEN", WithBaseURL(ts.URL), WithLogger(NopLogger()))
+	if _, err := api.CreateRelease(model.ReleaseOptions{FilePath: filePath}); err == nil || !strings.Contains(err.Error(), "chunk list") {
+		t.Fatalf("Chunk list error expected, got: %v", err)
+	}
+	if uploadedChunks != 0 {
+		t.Fatalf("No chunks expected to be uploaded, got: %d", uploadedChunks)
+	}
+}
+
+func TestCreateAPIWithClientParamsOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-token") != "MYTOKEN" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	api := CreateAPIWithClientParams("MYTOKEN", WithBaseURL(ts.URL), WithRetryMax(0), WithLogger(NopLogger()))
+
+	if err := api.AddTesterToRelease("tester@example.com", 1, model.ReleaseOptions{}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if api.maxAttemptsReached(maxAttempts-1) || !api.maxAttemptsReached(maxAttempts) {
+		t.Fatalf("Default poll bound of %d attempts expected", maxAttempts)
+	}
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewWithPollOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-token") != "MYTOKEN" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"upload_status":"uploadStarted"}`))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.apk")
+	if err := os.WriteFile(filePath, []byte("0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	sessionPath := filepath.Join(dir, "session.json")
+	b, err := json.Marshal(model.UploadSession{FilePath: filePath, FileSize: 10, ChunkSize: 10, ChunkList: []int{1}, UploadFinished: true, ReleasePatched: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(sessionPath, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	transport := &countingTransport{}
+	api := New("MYTOKEN",
+		WithBaseURL(ts.URL),
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithPollInterval(time.Millisecond),
+		WithMaxPollAttempts(3),
+		WithLogger(NopLogger()))
+
+	_, err = api.ResumeRelease(model.ReleaseOptions{UploadSessionPath: sessionPath})
+	if err == nil || !strings.Contains(err.Error(), "not ready after 3 attempts") {
+		t.Fatalf("Not ready error expected, got: %v", err)
+	}
+	if transport.requests != 3 {
+		t.Fatalf("3 status requests expected, got: %d", transport.requests)
+	}
+}
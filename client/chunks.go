@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ChunkError describes a chunk which could not be uploaded.
+type ChunkError struct {
+	ChunkID    int
+	StatusCode int
+	ErrorCode  string
+	Err        error
+}
+
+func (e *ChunkError) Error() string {
+	msg := fmt.Sprintf("failed to upload chunk, chunk id: %d", e.ChunkID)
+	if e.StatusCode > 0 {
+		msg += fmt.Sprintf(", status code: %d", e.StatusCode)
+	}
+	if e.ErrorCode != "" {
+		msg += ", error code: " + e.ErrorCode
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+// Unwrap ...
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// ChunkUploadError collects the chunks which failed to upload before the release upload was aborted.
+type ChunkUploadError struct {
+	Chunks []*ChunkError
+}
+
+func (e *ChunkUploadError) Error() string {
+	msgs := make([]string, 0, len(e.Chunks))
+	for _, chunkErr := range e.Chunks {
+		msgs = append(msgs, chunkErr.Error())
+	}
+
+	return fmt.Sprintf("%d chunk(s) failed to upload: %s", len(e.Chunks), strings.Join(msgs, "; "))
+}
+
+// Unwrap ...
+func (e *ChunkUploadError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Chunks))
+	for _, chunkErr := range e.Chunks {
+		errs = append(errs, chunkErr)
+	}
+
+	return errs
+}
+
+// uploadChunksInParallel uploads the chunks with bounded concurrency.
+// The first chunk which fails all of its attempts cancels the remaining uploads,
+// every failure seen until then is returned in a ChunkUploadError.
+func (api API) uploadChunksInParallel(ctx context.Context, fileChunks []*io.SectionReader, chunkIDs []int, session *uploadSession, progress *chunkProgress) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(api.chunkConcurrency())
+
+	var (
+		mu       sync.Mutex
+		failures []*ChunkError
+	)
+
+	for idx, chunkID := range chunkIDs {
+		chunk, ID := fileChunks[idx], chunkID
+
+		g.Go(func() error {
+			if gctx.Err() != nil {
+				return nil
+			}
+
+			if err := api.uploadChunk(gctx, chunk, ID, session); err != nil {
+				if gctx.Err() != nil {
+					// Aborted because an other chunk failed, or the caller cancelled the upload or its deadline expired.
+					return nil
+				}
+
+				mu.Lock()
+				failures = append(failures, err)
+				mu.Unlock()
+
+				return err
+			}
+
+			if err := session.chunkUploaded(ID); err != nil {
+				chunkErr := &ChunkError{ChunkID: ID, Err: err}
+
+				mu.Lock()
+				failures = append(failures, chunkErr)
+				mu.Unlock()
+
+				return chunkErr
+			}
+
+			progress.chunkDone(chunk.Size())
+
+			return nil
+		})
+	}
+
+	// The returned error is the first failure, which is already collected.
+	_ = g.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if len(failures) > 0 {
+		return &ChunkUploadError{Chunks: failures}
+	}
+
+	return nil
+}
+
+func (api API) chunkConcurrency() int {
+	if api.concurrency > 0 {
+		return api.concurrency
+	}
+
+	return maxConcurrentChunkUploads
+}
+
+// uploadChunk uploads a single chunk. Transport and server errors are already retried by the http client,
+// so only the failures reported by the upload domain in the response body are retried here, up to maxChunkAttempts times.
+func (api API) uploadChunk(ctx context.Context, chunk *io.SectionReader, ID int, session *uploadSession) *ChunkError {
+	var chunkErr *ChunkError
+
+	for attempt := 1; attempt <= maxChunkAttempts; attempt++ {
+		api.log().Debug("Uploading chunk", F("chunk_id", ID), F("size", chunk.Size()), F("attempt", attempt))
+
+		chunkErr = api.uploadChunkOnce(ctx, chunk, ID, session)
+		if chunkErr == nil {
+			api.log().Debug("Chunk uploaded", F("chunk_id", ID))
+			return nil
+		}
+
+		if !chunkErr.retryable() || attempt == maxChunkAttempts {
+			break
+		}
+
+		api.log().Warn("Chunk upload failed, retrying",
+			F("chunk_id", ID),
+			F("attempt", attempt),
+			F("error", chunkErr))
+
+		select {
+		case <-ctx.Done():
+			return &ChunkError{ChunkID: ID, Err: ctx.Err()}
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+
+	return chunkErr
+}
+
+func (api API) uploadChunkOnce(ctx context.Context, chunk *io.SectionReader, ID int, session *uploadSession) *ChunkError {
+	var (
+		chunkUploadURL = fmt.Sprintf("%s/upload/upload_chunk/%s?block_number=%s&token=%s",
+			session.UploadDomain,
+			session.PackageAssetID,
+			strconv.Itoa(ID),
+			session.URLEncodedToken)
+		chunkUploadResponse struct {
+			Error     bool   `json:"error"`
+			ErrorCode string `json:"error_code"`
+		}
+	)
+
+	statusCode, err := api.Client.streamRequest(ctx, http.MethodPost, chunkUploadURL, chunk, &chunkUploadResponse)
+	if err != nil {
+		chunkErr := &ChunkError{ChunkID: ID, Err: err}
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			chunkErr.StatusCode = apiErr.StatusCode
+			chunkErr.ErrorCode = apiErr.Err.Code
+		}
+
+		return chunkErr
+	}
+
+	if chunkUploadResponse.Error {
+		return &ChunkError{ChunkID: ID, StatusCode: statusCode, ErrorCode: chunkUploadResponse.ErrorCode}
+	}
+
+	if statusCode != http.StatusOK {
+		return &ChunkError{ChunkID: ID, StatusCode: statusCode, Err: newAPIError(http.MethodPost, chunkUploadURL, statusCode)}
+	}
+
+	return nil
+}
+
+// retryable reports whether the chunk failed with an error:true response of the upload domain.
+// Request errors are not retried, as the http client has already retried them.
+func (e *ChunkError) retryable() bool {
+	return e.Err == nil
+}
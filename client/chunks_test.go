@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestUploadChunksInParallel(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts = map[string]int{}
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chunkID := r.URL.Query().Get("block_number")
+
+		mu.Lock()
+		attempts[chunkID]++
+		attempt := attempts[chunkID]
+		mu.Unlock()
+
+		switch {
+		case chunkID == "2" && attempt == 1:
+			_, _ = w.Write([]byte(`{"error":true,"error_code":"Timeout"}`))
+		case chunkID == "3":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":true,"error_code":"InvalidBlock"}`))
+		default:
+			_, _ = w.Write([]byte(`{"error":false}`))
+		}
+	}))
+	defer ts.Close()
+
+	api := CreateAPIWithClientParams("MYTOKEN", WithLogger(NopLogger()))
+	session := newUploadSession("", model.UploadSession{UploadDomain: ts.URL})
+	content := strings.NewReader("0123456789")
+	chunks := []*io.SectionReader{io.NewSectionReader(content, 0, 5), io.NewSectionReader(content, 5, 5)}
+
+	err := api.uploadChunksInParallel(context.Background(), chunks, []int{1, 2}, session, &chunkProgress{api: api})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if attempts["2"] != 2 {
+		t.Fatalf("Chunk 2 expected to be retried once, got %d attempts", attempts["2"])
+	}
+
+	err = api.uploadChunksInParallel(context.Background(), chunks[:1], []int{3}, session, &chunkProgress{api: api})
+
+	var uploadErr *ChunkUploadError
+	if !errors.As(err, &uploadErr) || len(uploadErr.Chunks) != 1 {
+		t.Fatalf("ChunkUploadError expected, got: %v", err)
+	}
+	if chunkErr := uploadErr.Chunks[0]; chunkErr.ChunkID != 3 || chunkErr.StatusCode != http.StatusBadRequest || chunkErr.ErrorCode != "InvalidBlock" {
+		t.Fatalf("Failed chunk 3 expected, got: %+v", chunkErr)
+	}
+	if attempts["3"] != 1 {
+		t.Fatalf("Client errors expected not to be retried, got %d attempts", attempts["3"])
+	}
+}
+
+func TestUploadChunksInParallelRetriesServerErrorsOnce(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	api := CreateAPIWithClientParams("MYTOKEN", WithRetryMax(1), WithLogger(NopLogger()))
+	session := newUploadSession("", model.UploadSession{UploadDomain: ts.URL})
+	chunks := []*io.SectionReader{io.NewSectionReader(strings.NewReader("01234"), 0, 5)}
+
+	err := api.uploadChunksInParallel(context.Background(), chunks, []int{1}, session, &chunkProgress{api: api})
+
+	var uploadErr *ChunkUploadError
+	if !errors.As(err, &uploadErr) {
+		t.Fatalf("ChunkUploadError expected, got: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Fatalf("Only the http client retry expected, got %d requests", got)
+	}
+}
+
+func TestUploadChunksInParallelDeadline(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(300 * time.Millisecond):
+		}
+	}))
+	defer ts.Close()
+
+	api := CreateAPIWithClientParams("MYTOKEN", WithLogger(NopLogger()))
+	session := newUploadSession("", model.UploadSession{UploadDomain: ts.URL})
+	content := strings.NewReader("0123456789")
+	chunks := []*io.SectionReader{io.NewSectionReader(content, 0, 5), io.NewSectionReader(content, 5, 5)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := api.uploadChunksInParallel(ctx, chunks, []int{1, 2}, session, &chunkProgress{api: api})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("context.DeadlineExceeded expected, got: %v", err)
+	}
+}
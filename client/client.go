@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,7 +11,6 @@ import (
 	"os"
 	"strconv"
 
-	"github.com/bitrise-io/go-utils/log"
 	"github.com/bitrise-io/go-utils/retry"
 	"github.com/hashicorp/go-retryablehttp"
 )
@@ -21,6 +21,7 @@ const (
 
 type roundTripper struct {
 	token string
+	base  http.RoundTripper
 }
 
 // RoundTrip ...
@@ -32,70 +33,120 @@ func (rt roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 		"content-type", "application/json; charset=utf-8",
 	)
 
+	if rt.base != nil {
+		return rt.base.RoundTrip(req)
+	}
+
 	return http.DefaultTransport.RoundTrip(req)
 }
 
 // Client ...
 type Client struct {
 	httpClient *retryablehttp.Client
+	logger     Logger
 }
 
 // NewClient returns an AppCenter authenticated client
-func NewClient(token string) Client {
+func NewClient(token string, opts ...Option) Client {
+	cfg := newConfig(opts)
+
 	retClient := retry.NewHTTPClient()
+	if cfg.httpClient != nil {
+		httpClient := *cfg.httpClient
+		retClient.HTTPClient = &httpClient
+	}
 	retClient.HTTPClient.Transport = &roundTripper{
 		token: token,
+		base:  retClient.HTTPClient.Transport,
+	}
+	if cfg.timeout > 0 {
+		retClient.HTTPClient.Timeout = cfg.timeout
+	}
+	if cfg.retryMax >= 0 {
+		retClient.RetryMax = cfg.retryMax
 	}
+	retClient.Logger = retryLogger{logger: cfg.logger}
 
 	return Client{
 		httpClient: retClient,
+		logger:     cfg.logger,
 	}
 }
 
-func (c Client) jsonRequest(method, url string, body []byte, response interface{}) (int, error) {
+func (c Client) log() Logger {
+	if c.logger == nil {
+		return NewDefaultLogger()
+	}
+
+	return c.logger
+}
+
+func (c Client) jsonRequest(ctx context.Context, method, url string, body []byte, response interface{}) (int, error) {
 	var reader io.Reader
 
 	if body != nil {
 		reader = bytes.NewReader(body)
 	}
 
-	req, err := retryablehttp.NewRequest(method, url, reader)
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return -1, err
+	}
 
+	return c.do(req, response)
+}
+
+// streamRequest sends the given file section as the request body, without loading it into memory.
+func (c Client) streamRequest(ctx context.Context, method, url string, body *io.SectionReader, response interface{}) (int, error) {
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, url, sectionBody(body))
 	if err != nil {
 		return -1, err
 	}
+	req.ContentLength = body.Size()
+
+	return c.do(req, response)
+}
 
+func (c Client) do(req *retryablehttp.Request, response interface{}) (int, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return -1, err
+		return -1, redactTransportError(err, req.Method, req.URL.String())
 	}
 
 	defer func() {
-		if resp != nil {
-			if err := resp.Body.Close(); err != nil {
-				log.Warnf("failed to close body: %s", err)
-			}
+		if err := resp.Body.Close(); err != nil {
+			c.log().Warn("failed to close body", F("error", err))
 		}
 	}()
 
-	if resp != nil && response != nil {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		rb, err := io.ReadAll(io.LimitReader(resp.Body, 64*maxErrorBodyLength))
+		if err != nil {
+			return resp.StatusCode, err
+		}
+
+		return resp.StatusCode, newAPIErrorFromResponse(resp, rb)
+	}
+
+	if response != nil {
 		rb, err := io.ReadAll(resp.Body)
 		if err != nil {
 			return -1, err
 		}
 
-		if err := json.Unmarshal(rb, response); err != nil {
-			reqDump, err := httputil.DumpRequestOut(resp.Request, true)
-			if err != nil {
-				log.Warnf("failed to dump request: %v", err)
+		if decodeErr := json.Unmarshal(rb, response); decodeErr != nil {
+			if len(rb) > maxErrorBodyLength {
+				rb = rb[:maxErrorBodyLength]
 			}
 
+			// the request is not dumped, its headers hold the API token
 			respDump, err := httputil.DumpResponse(resp, false)
 			if err != nil {
-				log.TWarnf("failed to dump response: %s", err)
+				c.log().Warn("failed to dump response", F("error", err))
 			}
 
-			return resp.StatusCode, fmt.Errorf("failed to unmarshal response: %s, request: %s, response headers: %s response body: %s", err, reqDump, respDump, string(rb))
+			return resp.StatusCode, fmt.Errorf("failed to unmarshal response: %w, request: %s %s, response headers: %s response body: %s",
+				decodeErr, resp.Request.Method, redactURL(resp.Request.URL.String()), respDump, string(rb))
 		}
 	}
 
@@ -112,30 +163,38 @@ func (c Client) MarshallContent(content interface{}) ([]byte, error) {
 	return b, err
 }
 
-func (c Client) uploadFile(url string, filePath string) (int, error) {
-	fb, err := os.ReadFile(filePath)
+func (c Client) uploadFile(ctx context.Context, url string, filePath string) (int, error) {
+	f, err := os.Open(filePath)
 	if err != nil {
 		return -1, err
 	}
 
-	uploadReq, err := retryablehttp.NewRequest("PUT", url, bytes.NewReader(fb))
+	defer func() {
+		if err := f.Close(); err != nil {
+			c.log().Warn("failed to close file", F("path", filePath), F("error", err))
+		}
+	}()
+
+	info, err := f.Stat()
 	if err != nil {
 		return -1, err
 	}
 
-	uploadReq.Header.Set("x-ms-blob-type", "BlockBlob")
-	uploadReq.Header.Set("content-length", strconv.Itoa(len(fb)))
-
-	resp, err := c.httpClient.Do(uploadReq)
+	uploadReq, err := retryablehttp.NewRequestWithContext(ctx, "PUT", url, sectionBody(io.NewSectionReader(f, 0, info.Size())))
 	if err != nil {
 		return -1, err
 	}
 
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Warnf("failed to close body: %s", err)
-		}
-	}()
+	uploadReq.ContentLength = info.Size()
+	uploadReq.Header.Set("x-ms-blob-type", "BlockBlob")
+	uploadReq.Header.Set("content-length", strconv.FormatInt(info.Size(), 10))
 
-	return resp.StatusCode, nil
+	return c.do(uploadReq, nil)
+}
+
+// sectionBody returns a body which is re-read from the start of the section on every retry attempt.
+func sectionBody(section *io.SectionReader) retryablehttp.ReaderFunc {
+	return func() (io.Reader, error) {
+		return io.NewSectionReader(section, 0, section.Size()), nil
+	}
 }
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// RemoveReleaseFromGroup ...
+func (api API) RemoveReleaseFromGroup(g model.Group, releaseID int, opts model.ReleaseOptions) error {
+	return api.RemoveReleaseFromGroupContext(context.Background(), g, releaseID, opts)
+}
+
+// RemoveReleaseFromGroupContext ...
+func (api API) RemoveReleaseFromGroupContext(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error {
+	deleteURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d/groups/%s", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID, g.ID)
+
+	return api.deleteDestination(ctx, deleteURL)
+}
+
+// RemoveReleaseFromStore ...
+func (api API) RemoveReleaseFromStore(s model.Store, releaseID int, opts model.ReleaseOptions) error {
+	return api.RemoveReleaseFromStoreContext(context.Background(), s, releaseID, opts)
+}
+
+// RemoveReleaseFromStoreContext ...
+func (api API) RemoveReleaseFromStoreContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error {
+	deleteURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d/stores/%s", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID, s.ID)
+
+	return api.deleteDestination(ctx, deleteURL)
+}
+
+// RemoveTesterFromRelease ...
+func (api API) RemoveTesterFromRelease(email string, releaseID int, opts model.ReleaseOptions) error {
+	return api.RemoveTesterFromReleaseContext(context.Background(), email, releaseID, opts)
+}
+
+// RemoveTesterFromReleaseContext looks up the tester destination of the release by email,
+// the API identifies the tester by its destination ID.
+func (api API) RemoveTesterFromReleaseContext(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error {
+	release, err := api.GetAppReleaseDetailsContext(ctx, opts.App, releaseID)
+	if err != nil {
+		return err
+	}
+
+	testerID := ""
+	for _, d := range release.Destinations {
+		if d.DestinationType == "tester" && strings.EqualFold(d.Name, email) {
+			testerID = d.ID
+			break
+		}
+	}
+	if testerID == "" {
+		return fmt.Errorf("tester %s on release %d: %w", email, releaseID, ErrDestinationNotFound)
+	}
+
+	deleteURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d/testers/%s", api.baseURL, opts.App.Owner, opts.App.AppName, releaseID, testerID)
+
+	return api.deleteDestination(ctx, deleteURL)
+}
+
+func (api API) deleteDestination(ctx context.Context, deleteURL string) error {
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodDelete, deleteURL, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent {
+		return newAPIError(http.MethodDelete, deleteURL, statusCode)
+	}
+
+	return nil
+}
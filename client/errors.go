@@ -0,0 +1,185 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+const maxErrorBodyLength = 1024
+
+// ErrReleaseNotFound is returned when no release matches a lookup.
+var ErrReleaseNotFound = errors.New("release not found")
+
+// ErrDestinationNotFound is returned when the release is not distributed to the destination to remove.
+var ErrDestinationNotFound = errors.New("destination not found")
+
+// ErrStorePublishTimeout is returned when the store publishing does not finish within the poll attempts.
+var ErrStorePublishTimeout = errors.New("store publishing is not finished")
+
+// StorePublishError is returned when publishing the release to a store ends with a failure status.
+type StorePublishError struct {
+	ReleaseID int
+	StoreID   string
+	StoreName string
+	Status    string
+}
+
+func (e *StorePublishError) Error() string {
+	return fmt.Sprintf("failed to publish release %d to store %s, publishing status: %s", e.ReleaseID, e.StoreName, e.Status)
+}
+
+// redactedQueryParams are the query parameters holding upload tokens and signatures.
+var redactedQueryParams = []string{"token", "sig"}
+
+// APIError is returned when AppCenter responds with an unexpected status code.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Err is the error decoded from the response body, if any.
+	Err       model.Error
+	RequestID string
+	// Body is the beginning of the raw response body, set when it could not be decoded.
+	Body string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("invalid status code: %d, method: %s, url: %s", e.StatusCode, e.Method, e.URL)
+	if e.Err.Code != "" || e.Err.Message != "" {
+		msg += ", error: " + e.Err.String()
+	} else if e.Body != "" {
+		msg += ", body: " + e.Body
+	}
+	if e.RequestID != "" {
+		msg += ", request id: " + e.RequestID
+	}
+
+	return msg
+}
+
+// IsNotFound reports whether err is an APIError with 404 status code, ErrReleaseNotFound or ErrDestinationNotFound.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound) || errors.Is(err, ErrReleaseNotFound) || errors.Is(err, ErrDestinationNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError with 401 or 403 status code.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized) || hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is an APIError with 409 status code.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// newAPIError is used when a request succeeded with a status code other than the expected one.
+func newAPIError(method, rawURL string, statusCode int) *APIError {
+	return &APIError{
+		Method:     method,
+		URL:        redactURL(rawURL),
+		StatusCode: statusCode,
+	}
+}
+
+func newAPIErrorFromResponse(resp *http.Response, body []byte) *APIError {
+	apiErr := newAPIError(resp.Request.Method, resp.Request.URL.String(), resp.StatusCode)
+	apiErr.RequestID = requestID(resp.Header)
+
+	if decoded, ok := decodeErrorBody(body); ok {
+		apiErr.Err = decoded
+	} else {
+		if len(body) > maxErrorBodyLength {
+			body = body[:maxErrorBodyLength]
+		}
+		apiErr.Body = string(body)
+	}
+
+	return apiErr
+}
+
+// decodeErrorBody handles both the {"code": ..., "message": ...} and the
+// {"error": {"code": ..., "message": ...}} error formats, and the error_code of the upload domain.
+func decodeErrorBody(body []byte) (model.Error, bool) {
+	var flat struct {
+		Code      string `json:"code"`
+		Message   string `json:"message"`
+		ErrorCode string `json:"error_code"`
+	}
+	if err := json.Unmarshal(body, &flat); err == nil {
+		if flat.Code == "" {
+			flat.Code = flat.ErrorCode
+		}
+		if flat.Code != "" || flat.Message != "" {
+			return model.Error{Code: flat.Code, Message: flat.Message}, true
+		}
+	}
+
+	var nested struct {
+		Error model.Error `json:"error"`
+	}
+	if err := json.Unmarshal(body, &nested); err == nil {
+		if nested.Error.Code != "" || nested.Error.Message != "" {
+			return nested.Error, true
+		}
+	}
+
+	return model.Error{}, false
+}
+
+func requestID(header http.Header) string {
+	for _, key := range []string{"x-ms-request-id", "x-request-id", "x-ms-correlation-id"} {
+		if id := header.Get(key); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// redactTransportError replaces the error of a failed request with one holding the redacted URL,
+// as both the retry client and net/http put the full URL, with the upload token, into the error message.
+func redactTransportError(err error, method, rawURL string) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return &url.Error{Op: method, URL: redactURL(rawURL), Err: urlErr.Err}
+	}
+
+	if msg := err.Error(); strings.Contains(msg, rawURL) {
+		return errors.New(strings.ReplaceAll(msg, rawURL, redactURL(rawURL)))
+	}
+
+	return err
+}
+
+// redactURL hides the upload tokens and signatures so the URL is safe to log.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	query := u.Query()
+	redacted := false
+	for _, key := range redactedQueryParams {
+		if query.Has(key) {
+			query.Set(key, "REDACTED")
+			redacted = true
+		}
+	}
+	if redacted {
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String()
+}
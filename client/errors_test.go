@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestGetGroupByNameReturnsAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ms-request-id", "request-1")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":"NotFound","message":"The distribution group could not be found"}}`))
+	}))
+	defer ts.Close()
+
+	api := New("MYTOKEN", WithBaseURL(ts.URL), WithLogger(NopLogger()))
+
+	_, err := api.GetGroupByName("QA", model.App{Owner: "owner", AppName: "app"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("APIError expected, got: %v", err)
+	}
+	if !IsNotFound(err) || IsConflict(err) {
+		t.Fatalf("Not found error expected, got: %v", err)
+	}
+	if apiErr.Method != http.MethodGet || apiErr.Err.Code != "NotFound" || apiErr.RequestID != "request-1" {
+		t.Fatalf("Decoded error expected, got: %+v", apiErr)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	redacted := redactURL("https://upload.example.com/upload/finished/asset?token=secret&block_number=1")
+
+	if strings.Contains(redacted, "secret") || !strings.Contains(redacted, "block_number=1") {
+		t.Fatalf("Token expected to be redacted, got: %s", redacted)
+	}
+}
+
+func TestDecodeErrorHidesToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	}))
+	defer ts.Close()
+
+	api := New("MYTOKEN", WithBaseURL(ts.URL), WithLogger(NopLogger()))
+
+	_, err := api.GetGroupByName("QA", model.App{Owner: "owner", AppName: "app"})
+	if err == nil {
+		t.Fatal("Decode error expected")
+	}
+
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) || strings.Contains(err.Error(), "MYTOKEN") || strings.Contains(err.Error(), "%!") {
+		t.Fatalf("Decode error without the API token expected, got: %v", err)
+	}
+}
+
+func TestTransportErrorHidesUploadToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := ts.URL
+	ts.Close()
+
+	c := NewClient("MYTOKEN", WithRetryMax(0), WithLogger(NopLogger()))
+
+	_, err := c.jsonRequest(context.Background(), http.MethodPost, url+"/upload/finished/asset?token=secret", nil, nil)
+	if err == nil || strings.Contains(err.Error(), "secret") || !strings.Contains(err.Error(), "/upload/finished/asset") {
+		t.Fatalf("Transport error with redacted URL expected, got: %v", err)
+	}
+}
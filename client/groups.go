@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// CreateGroup ...
+func (api API) CreateGroup(opts model.GroupOptions, app model.App) (model.Group, error) {
+	return api.CreateGroupContext(context.Background(), opts, app)
+}
+
+// CreateGroupContext ...
+func (api API) CreateGroupContext(ctx context.Context, opts model.GroupOptions, app model.App) (model.Group, error) {
+	if strings.TrimSpace(opts.Name) == "" {
+		return model.Group{}, errors.New("group name is required")
+	}
+
+	var (
+		postURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups", api.baseURL, app.Owner, app.AppName)
+		postResponse model.Group
+	)
+
+	body, err := api.Client.MarshallContent(opts)
+	if err != nil {
+		return model.Group{}, err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, &postResponse)
+	if err != nil {
+		return model.Group{}, err
+	}
+
+	if statusCode != http.StatusCreated {
+		return model.Group{}, newAPIError(http.MethodPost, postURL, statusCode)
+	}
+
+	return postResponse, nil
+}
+
+// UpdateGroup ...
+func (api API) UpdateGroup(groupName string, update model.GroupUpdate, app model.App) (model.Group, error) {
+	return api.UpdateGroupContext(context.Background(), groupName, update, app)
+}
+
+// UpdateGroupContext returns the updated group, which is available by the new name when it was renamed.
+func (api API) UpdateGroupContext(ctx context.Context, groupName string, update model.GroupUpdate, app model.App) (model.Group, error) {
+	var (
+		patchURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s", api.baseURL, app.Owner, app.AppName, url.PathEscape(groupName))
+		patchResponse model.Group
+	)
+
+	body, err := api.Client.MarshallContent(update)
+	if err != nil {
+		return model.Group{}, err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPatch, patchURL, body, &patchResponse)
+	if err != nil {
+		return model.Group{}, err
+	}
+
+	if statusCode != http.StatusOK {
+		return model.Group{}, newAPIError(http.MethodPatch, patchURL, statusCode)
+	}
+
+	return patchResponse, nil
+}
+
+// DeleteGroup ...
+func (api API) DeleteGroup(groupName string, app model.App) error {
+	return api.DeleteGroupContext(context.Background(), groupName, app)
+}
+
+// DeleteGroupContext ...
+func (api API) DeleteGroupContext(ctx context.Context, groupName string, app model.App) error {
+	deleteURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s", api.baseURL, app.Owner, app.AppName, url.PathEscape(groupName))
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodDelete, deleteURL, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent {
+		return newAPIError(http.MethodDelete, deleteURL, statusCode)
+	}
+
+	return nil
+}
+
+// GetGroupMembers ...
+func (api API) GetGroupMembers(groupName string, app model.App) ([]model.GroupMember, error) {
+	return api.GetGroupMembersContext(context.Background(), groupName, app)
+}
+
+// GetGroupMembersContext ...
+func (api API) GetGroupMembersContext(ctx context.Context, groupName string, app model.App) ([]model.GroupMember, error) {
+	var (
+		getURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s/members", api.baseURL, app.Owner, app.AppName, url.PathEscape(groupName))
+		getResponse []model.GroupMember
+	)
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, newAPIError(http.MethodGet, getURL, statusCode)
+	}
+
+	return getResponse, nil
+}
+
+// AddGroupMembers ...
+func (api API) AddGroupMembers(groupName string, emails []string, app model.App) ([]model.GroupMemberResult, error) {
+	return api.AddGroupMembersContext(context.Background(), groupName, emails, app)
+}
+
+// AddGroupMembersContext invites the emails to the group in one request,
+// the returned results tell which emails could not be added.
+func (api API) AddGroupMembersContext(ctx context.Context, groupName string, emails []string, app model.App) ([]model.GroupMemberResult, error) {
+	var (
+		postURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s/members", api.baseURL, app.Owner, app.AppName, url.PathEscape(groupName))
+		postResponse []model.GroupMemberResult
+	)
+
+	body, err := api.Client.MarshallContent(groupMembersRequest{UserEmails: emails})
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, &postResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
+		return nil, newAPIError(http.MethodPost, postURL, statusCode)
+	}
+
+	return postResponse, nil
+}
+
+// RemoveGroupMembers ...
+func (api API) RemoveGroupMembers(groupName string, emails []string, app model.App) error {
+	return api.RemoveGroupMembersContext(context.Background(), groupName, emails, app)
+}
+
+// RemoveGroupMembersContext ...
+func (api API) RemoveGroupMembersContext(ctx context.Context, groupName string, emails []string, app model.App) error {
+	postURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s/members/bulk_delete", api.baseURL, app.Owner, app.AppName, url.PathEscape(groupName))
+
+	body, err := api.Client.MarshallContent(groupMembersRequest{UserEmails: emails})
+	if err != nil {
+		return err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent {
+		return newAPIError(http.MethodPost, postURL, statusCode)
+	}
+
+	return nil
+}
+
+type groupMembersRequest struct {
+	UserEmails []string `json:"user_emails"`
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// AppCenterAPI is the method set of API, it can be replaced with a mock in tests of the consumers.
+type AppCenterAPI interface {
+	GetAppReleaseDetails(app model.App, releaseID int) (model.Release, error)
+	GetAppReleaseDetailsContext(ctx context.Context, app model.App, releaseID int) (model.Release, error)
+	GetGroupByName(groupName string, app model.App) (model.Group, error)
+	GetGroupByNameContext(ctx context.Context, groupName string, app model.App) (model.Group, error)
+	GetAllGroups(app model.App) ([]model.Group, error)
+	GetAllGroupsContext(ctx context.Context, app model.App) ([]model.Group, error)
+	CreateGroup(opts model.GroupOptions, app model.App) (model.Group, error)
+	CreateGroupContext(ctx context.Context, opts model.GroupOptions, app model.App) (model.Group, error)
+	UpdateGroup(groupName string, update model.GroupUpdate, app model.App) (model.Group, error)
+	UpdateGroupContext(ctx context.Context, groupName string, update model.GroupUpdate, app model.App) (model.Group, error)
+	DeleteGroup(groupName string, app model.App) error
+	DeleteGroupContext(ctx context.Context, groupName string, app model.App) error
+	GetGroupMembers(groupName string, app model.App) ([]model.GroupMember, error)
+	GetGroupMembersContext(ctx context.Context, groupName string, app model.App) ([]model.GroupMember, error)
+	AddGroupMembers(groupName string, emails []string, app model.App) ([]model.GroupMemberResult, error)
+	AddGroupMembersContext(ctx context.Context, groupName string, emails []string, app model.App) ([]model.GroupMemberResult, error)
+	RemoveGroupMembers(groupName string, emails []string, app model.App) error
+	RemoveGroupMembersContext(ctx context.Context, groupName string, emails []string, app model.App) error
+	GetOrgGroups(orgName string) ([]model.Group, error)
+	GetOrgGroupsContext(ctx context.Context, orgName string) ([]model.Group, error)
+	GetOrgGroupByName(groupName, orgName string) (model.Group, error)
+	GetOrgGroupByNameContext(ctx context.Context, groupName, orgName string) (model.Group, error)
+	CreateOrgGroup(opts model.GroupOptions, orgName string) (model.Group, error)
+	CreateOrgGroupContext(ctx context.Context, opts model.GroupOptions, orgName string) (model.Group, error)
+	AddAppsToOrgGroup(groupName string, appNames []string, orgName string) error
+	AddAppsToOrgGroupContext(ctx context.Context, groupName string, appNames []string, orgName string) error
+	GetStore(storeName string, app model.App) (model.Store, error)
+	GetStoreContext(ctx context.Context, storeName string, app model.App) (model.Store, error)
+	GetAllStores(app model.App) ([]model.Store, error)
+	GetAllStoresContext(ctx context.Context, app model.App) ([]model.Store, error)
+	CreateStore(opts model.StoreOptions, app model.App) (model.Store, error)
+	CreateStoreContext(ctx context.Context, opts model.StoreOptions, app model.App) (model.Store, error)
+	DeleteStore(storeName string, app model.App) error
+	DeleteStoreContext(ctx context.Context, storeName string, app model.App) error
+	AddReleaseToGroup(g model.Group, releaseID int, opts model.ReleaseOptions) error
+	AddReleaseToGroupContext(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error
+	AddReleaseToStore(s model.Store, releaseID int, opts model.ReleaseOptions) error
+	AddReleaseToStoreContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error
+	WaitForStorePublish(s model.Store, releaseID int, opts model.ReleaseOptions) (model.ReleaseDistributionStore, error)
+	WaitForStorePublishContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) (model.ReleaseDistributionStore, error)
+	AddTesterToRelease(email string, releaseID int, opts model.ReleaseOptions) error
+	AddTesterToReleaseContext(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error
+	RemoveReleaseFromGroup(g model.Group, releaseID int, opts model.ReleaseOptions) error
+	RemoveReleaseFromGroupContext(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error
+	RemoveReleaseFromStore(s model.Store, releaseID int, opts model.ReleaseOptions) error
+	RemoveReleaseFromStoreContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error
+	RemoveTesterFromRelease(email string, releaseID int, opts model.ReleaseOptions) error
+	RemoveTesterFromReleaseContext(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error
+	SetReleaseNoteOnRelease(releaseNote string, releaseID int, opts model.ReleaseOptions) error
+	SetReleaseNoteOnReleaseContext(ctx context.Context, releaseNote string, releaseID int, opts model.ReleaseOptions) error
+	UpdateRelease(update model.ReleaseUpdate, releaseID int, opts model.ReleaseOptions) error
+	UpdateReleaseContext(ctx context.Context, update model.ReleaseUpdate, releaseID int, opts model.ReleaseOptions) error
+	UploadSymbolToRelease(filePath string, release model.Release, opts model.ReleaseOptions) error
+	UploadSymbolToReleaseContext(ctx context.Context, filePath string, release model.Release, opts model.ReleaseOptions) error
+	CreateRelease(opts model.ReleaseOptions) (int, error)
+	CreateReleaseContext(ctx context.Context, opts model.ReleaseOptions) (int, error)
+	CreateReleaseWithResult(opts model.ReleaseOptions) (model.CreateReleaseResult, error)
+	CreateReleaseWithResultContext(ctx context.Context, opts model.ReleaseOptions) (model.CreateReleaseResult, error)
+	ResumeRelease(opts model.ReleaseOptions) (int, error)
+	ResumeReleaseContext(ctx context.Context, opts model.ReleaseOptions) (int, error)
+	ListReleases(app model.App, opts model.ReleaseListOptions) ([]model.ReleaseSummary, error)
+	ListReleasesContext(ctx context.Context, app model.App, opts model.ReleaseListOptions) ([]model.ReleaseSummary, error)
+	FindReleaseByVersion(app model.App, shortVersion, build string) (model.Release, error)
+	FindReleaseByVersionContext(ctx context.Context, app model.App, shortVersion, build string) (model.Release, error)
+	FindReleaseByHash(app model.App, sha256 string, maxReleases int) (model.Release, error)
+	FindReleaseByHashContext(ctx context.Context, app model.App, sha256 string, maxReleases int) (model.Release, error)
+	DeleteRelease(app model.App, releaseID int) error
+	DeleteReleaseContext(ctx context.Context, app model.App, releaseID int) error
+}
+
+var _ AppCenterAPI = API{}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/go-utils/log"
+)
+
+// Field is a key-value pair attached to a log message, like the release or chunk ID.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F ...
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Logger receives the log messages of the Client and the API.
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+}
+
+// NewDefaultLogger returns the Logger used when none is configured,
+// it prints the messages with their fields using the go-utils log package.
+func NewDefaultLogger() Logger {
+	return defaultLogger{}
+}
+
+// NopLogger returns a Logger which discards every message.
+func NopLogger() Logger {
+	return nopLogger{}
+}
+
+type defaultLogger struct{}
+
+// Debug ...
+func (defaultLogger) Debug(msg string, fields ...Field) {
+	log.Debugf("%s", formatMessage(msg, fields))
+}
+
+// Info ...
+func (defaultLogger) Info(msg string, fields ...Field) {
+	log.Printf("%s", formatMessage(msg, fields))
+}
+
+// Warn ...
+func (defaultLogger) Warn(msg string, fields ...Field) {
+	log.Warnf("%s", formatMessage(msg, fields))
+}
+
+// Error ...
+func (defaultLogger) Error(msg string, fields ...Field) {
+	log.Errorf("%s", formatMessage(msg, fields))
+}
+
+func formatMessage(msg string, fields []Field) string {
+	var b strings.Builder
+	b.WriteString(msg)
+	for _, f := range fields {
+		b.WriteString(fmt.Sprintf(" %s=%v", f.Key, f.Value))
+	}
+
+	return b.String()
+}
+
+type nopLogger struct{}
+
+// Debug ...
+func (nopLogger) Debug(string, ...Field) {}
+
+// Info ...
+func (nopLogger) Info(string, ...Field) {}
+
+// Warn ...
+func (nopLogger) Warn(string, ...Field) {}
+
+// Error ...
+func (nopLogger) Error(string, ...Field) {}
+
+// retryLogger forwards the logs of the retrying http client to a Logger.
+type retryLogger struct {
+	logger Logger
+}
+
+// Error ...
+func (l retryLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, toFields(keysAndValues)...)
+}
+
+// Info ...
+func (l retryLogger) Info(msg string, keysAndValues ...interface{}) {
+	// Request level messages of the retrying client are too verbose for the info level.
+	l.logger.Debug(msg, toFields(keysAndValues)...)
+}
+
+// Debug ...
+func (l retryLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, toFields(keysAndValues)...)
+}
+
+// Warn ...
+func (l retryLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, toFields(keysAndValues)...)
+}
+
+func toFields(keysAndValues []interface{}) []Field {
+	var fields []Field
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields = append(fields, F(fmt.Sprint(keysAndValues[i]), keysAndValues[i+1]))
+	}
+
+	return fields
+}
@@ -0,0 +1,549 @@
+// Package mock provides a hand-written implementation of client.AppCenterAPI for tests.
+package mock
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// ErrNotImplemented is returned by the methods whose function field is not set.
+var ErrNotImplemented = errors.New("mock: method not implemented")
+
+// API implements client.AppCenterAPI by calling the function field of the invoked method.
+// The methods without context call the Func of their Context variant with context.Background().
+type API struct {
+	GetAppReleaseDetailsFunc    func(ctx context.Context, app model.App, releaseID int) (model.Release, error)
+	GetGroupByNameFunc          func(ctx context.Context, groupName string, app model.App) (model.Group, error)
+	GetAllGroupsFunc            func(ctx context.Context, app model.App) ([]model.Group, error)
+	CreateGroupFunc             func(ctx context.Context, opts model.GroupOptions, app model.App) (model.Group, error)
+	UpdateGroupFunc             func(ctx context.Context, groupName string, update model.GroupUpdate, app model.App) (model.Group, error)
+	DeleteGroupFunc             func(ctx context.Context, groupName string, app model.App) error
+	GetGroupMembersFunc         func(ctx context.Context, groupName string, app model.App) ([]model.GroupMember, error)
+	AddGroupMembersFunc         func(ctx context.Context, groupName string, emails []string, app model.App) ([]model.GroupMemberResult, error)
+	RemoveGroupMembersFunc      func(ctx context.Context, groupName string, emails []string, app model.App) error
+	GetOrgGroupsFunc            func(ctx context.Context, orgName string) ([]model.Group, error)
+	GetOrgGroupByNameFunc       func(ctx context.Context, groupName, orgName string) (model.Group, error)
+	CreateOrgGroupFunc          func(ctx context.Context, opts model.GroupOptions, orgName string) (model.Group, error)
+	AddAppsToOrgGroupFunc       func(ctx context.Context, groupName string, appNames []string, orgName string) error
+	GetStoreFunc                func(ctx context.Context, storeName string, app model.App) (model.Store, error)
+	GetAllStoresFunc            func(ctx context.Context, app model.App) ([]model.Store, error)
+	CreateStoreFunc             func(ctx context.Context, opts model.StoreOptions, app model.App) (model.Store, error)
+	DeleteStoreFunc             func(ctx context.Context, storeName string, app model.App) error
+	AddReleaseToGroupFunc       func(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error
+	AddReleaseToStoreFunc       func(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error
+	WaitForStorePublishFunc     func(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) (model.ReleaseDistributionStore, error)
+	AddTesterToReleaseFunc      func(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error
+	RemoveReleaseFromGroupFunc  func(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error
+	RemoveReleaseFromStoreFunc  func(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error
+	RemoveTesterFromReleaseFunc func(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error
+	SetReleaseNoteOnReleaseFunc func(ctx context.Context, releaseNote string, releaseID int, opts model.ReleaseOptions) error
+	UpdateReleaseFunc           func(ctx context.Context, update model.ReleaseUpdate, releaseID int, opts model.ReleaseOptions) error
+	UploadSymbolToReleaseFunc   func(ctx context.Context, filePath string, release model.Release, opts model.ReleaseOptions) error
+	CreateReleaseFunc           func(ctx context.Context, opts model.ReleaseOptions) (int, error)
+	CreateReleaseWithResultFunc func(ctx context.Context, opts model.ReleaseOptions) (model.CreateReleaseResult, error)
+	ResumeReleaseFunc           func(ctx context.Context, opts model.ReleaseOptions) (int, error)
+	ListReleasesFunc            func(ctx context.Context, app model.App, opts model.ReleaseListOptions) ([]model.ReleaseSummary, error)
+	FindReleaseByVersionFunc    func(ctx context.Context, app model.App, shortVersion, build string) (model.Release, error)
+	FindReleaseByHashFunc       func(ctx context.Context, app model.App, sha256 string, maxReleases int) (model.Release, error)
+	DeleteReleaseFunc           func(ctx context.Context, app model.App, releaseID int) error
+
+	mu    sync.Mutex
+	calls []string
+}
+
+var _ client.AppCenterAPI = (*API)(nil)
+
+// Calls returns the names of the invoked methods in order, Context variants are recorded without the suffix.
+func (m *API) Calls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]string(nil), m.calls...)
+}
+
+func (m *API) record(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, method)
+}
+
+// GetAppReleaseDetails ...
+func (m *API) GetAppReleaseDetails(app model.App, releaseID int) (model.Release, error) {
+	return m.GetAppReleaseDetailsContext(context.Background(), app, releaseID)
+}
+
+// GetAppReleaseDetailsContext ...
+func (m *API) GetAppReleaseDetailsContext(ctx context.Context, app model.App, releaseID int) (model.Release, error) {
+	m.record("GetAppReleaseDetails")
+	if m.GetAppReleaseDetailsFunc == nil {
+		return model.Release{}, ErrNotImplemented
+	}
+	return m.GetAppReleaseDetailsFunc(ctx, app, releaseID)
+}
+
+// GetGroupByName ...
+func (m *API) GetGroupByName(groupName string, app model.App) (model.Group, error) {
+	return m.GetGroupByNameContext(context.Background(), groupName, app)
+}
+
+// GetGroupByNameContext ...
+func (m *API) GetGroupByNameContext(ctx context.Context, groupName string, app model.App) (model.Group, error) {
+	m.record("GetGroupByName")
+	if m.GetGroupByNameFunc == nil {
+		return model.Group{}, ErrNotImplemented
+	}
+	return m.GetGroupByNameFunc(ctx, groupName, app)
+}
+
+// GetAllGroups ...
+func (m *API) GetAllGroups(app model.App) ([]model.Group, error) {
+	return m.GetAllGroupsContext(context.Background(), app)
+}
+
+// GetAllGroupsContext ...
+func (m *API) GetAllGroupsContext(ctx context.Context, app model.App) ([]model.Group, error) {
+	m.record("GetAllGroups")
+	if m.GetAllGroupsFunc == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.GetAllGroupsFunc(ctx, app)
+}
+
+// CreateGroup ...
+func (m *API) CreateGroup(opts model.GroupOptions, app model.App) (model.Group, error) {
+	return m.CreateGroupContext(context.Background(), opts, app)
+}
+
+// CreateGroupContext ...
+func (m *API) CreateGroupContext(ctx context.Context, opts model.GroupOptions, app model.App) (model.Group, error) {
+	m.record("CreateGroup")
+	if m.CreateGroupFunc == nil {
+		return model.Group{}, ErrNotImplemented
+	}
+	return m.CreateGroupFunc(ctx, opts, app)
+}
+
+// UpdateGroup ...
+func (m *API) UpdateGroup(groupName string, update model.GroupUpdate, app model.App) (model.Group, error) {
+	return m.UpdateGroupContext(context.Background(), groupName, update, app)
+}
+
+// UpdateGroupContext ...
+func (m *API) UpdateGroupContext(ctx context.Context, groupName string, update model.GroupUpdate, app model.App) (model.Group, error) {
+	m.record("UpdateGroup")
+	if m.UpdateGroupFunc == nil {
+		return model.Group{}, ErrNotImplemented
+	}
+	return m.UpdateGroupFunc(ctx, groupName, update, app)
+}
+
+// DeleteGroup ...
+func (m *API) DeleteGroup(groupName string, app model.App) error {
+	return m.DeleteGroupContext(context.Background(), groupName, app)
+}
+
+// DeleteGroupContext ...
+func (m *API) DeleteGroupContext(ctx context.Context, groupName string, app model.App) error {
+	m.record("DeleteGroup")
+	if m.DeleteGroupFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.DeleteGroupFunc(ctx, groupName, app)
+}
+
+// GetGroupMembers ...
+func (m *API) GetGroupMembers(groupName string, app model.App) ([]model.GroupMember, error) {
+	return m.GetGroupMembersContext(context.Background(), groupName, app)
+}
+
+// GetGroupMembersContext ...
+func (m *API) GetGroupMembersContext(ctx context.Context, groupName string, app model.App) ([]model.GroupMember, error) {
+	m.record("GetGroupMembers")
+	if m.GetGroupMembersFunc == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.GetGroupMembersFunc(ctx, groupName, app)
+}
+
+// AddGroupMembers ...
+func (m *API) AddGroupMembers(groupName string, emails []string, app model.App) ([]model.GroupMemberResult, error) {
+	return m.AddGroupMembersContext(context.Background(), groupName, emails, app)
+}
+
+// AddGroupMembersContext ...
+func (m *API) AddGroupMembersContext(ctx context.Context, groupName string, emails []string, app model.App) ([]model.GroupMemberResult, error) {
+	m.record("AddGroupMembers")
+	if m.AddGroupMembersFunc == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.AddGroupMembersFunc(ctx, groupName, emails, app)
+}
+
+// RemoveGroupMembers ...
+func (m *API) RemoveGroupMembers(groupName string, emails []string, app model.App) error {
+	return m.RemoveGroupMembersContext(context.Background(), groupName, emails, app)
+}
+
+// RemoveGroupMembersContext ...
+func (m *API) RemoveGroupMembersContext(ctx context.Context, groupName string, emails []string, app model.App) error {
+	m.record("RemoveGroupMembers")
+	if m.RemoveGroupMembersFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.RemoveGroupMembersFunc(ctx, groupName, emails, app)
+}
+
+// GetOrgGroups ...
+func (m *API) GetOrgGroups(orgName string) ([]model.Group, error) {
+	return m.GetOrgGroupsContext(context.Background(), orgName)
+}
+
+// GetOrgGroupsContext ...
+func (m *API) GetOrgGroupsContext(ctx context.Context, orgName string) ([]model.Group, error) {
+	m.record("GetOrgGroups")
+	if m.GetOrgGroupsFunc == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.GetOrgGroupsFunc(ctx, orgName)
+}
+
+// GetOrgGroupByName ...
+func (m *API) GetOrgGroupByName(groupName, orgName string) (model.Group, error) {
+	return m.GetOrgGroupByNameContext(context.Background(), groupName, orgName)
+}
+
+// GetOrgGroupByNameContext ...
+func (m *API) GetOrgGroupByNameContext(ctx context.Context, groupName, orgName string) (model.Group, error) {
+	m.record("GetOrgGroupByName")
+	if m.GetOrgGroupByNameFunc == nil {
+		return model.Group{}, ErrNotImplemented
+	}
+	return m.GetOrgGroupByNameFunc(ctx, groupName, orgName)
+}
+
+// CreateOrgGroup ...
+func (m *API) CreateOrgGroup(opts model.GroupOptions, orgName string) (model.Group, error) {
+	return m.CreateOrgGroupContext(context.Background(), opts, orgName)
+}
+
+// CreateOrgGroupContext ...
+func (m *API) CreateOrgGroupContext(ctx context.Context, opts model.GroupOptions, orgName string) (model.Group, error) {
+	m.record("CreateOrgGroup")
+	if m.CreateOrgGroupFunc == nil {
+		return model.Group{}, ErrNotImplemented
+	}
+	return m.CreateOrgGroupFunc(ctx, opts, orgName)
+}
+
+// AddAppsToOrgGroup ...
+func (m *API) AddAppsToOrgGroup(groupName string, appNames []string, orgName string) error {
+	return m.AddAppsToOrgGroupContext(context.Background(), groupName, appNames, orgName)
+}
+
+// AddAppsToOrgGroupContext ...
+func (m *API) AddAppsToOrgGroupContext(ctx context.Context, groupName string, appNames []string, orgName string) error {
+	m.record("AddAppsToOrgGroup")
+	if m.AddAppsToOrgGroupFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.AddAppsToOrgGroupFunc(ctx, groupName, appNames, orgName)
+}
+
+// GetStore ...
+func (m *API) GetStore(storeName string, app model.App) (model.Store, error) {
+	return m.GetStoreContext(context.Background(), storeName, app)
+}
+
+// GetStoreContext ...
+func (m *API) GetStoreContext(ctx context.Context, storeName string, app model.App) (model.Store, error) {
+	m.record("GetStore")
+	if m.GetStoreFunc == nil {
+		return model.Store{}, ErrNotImplemented
+	}
+	return m.GetStoreFunc(ctx, storeName, app)
+}
+
+// GetAllStores ...
+func (m *API) GetAllStores(app model.App) ([]model.Store, error) {
+	return m.GetAllStoresContext(context.Background(), app)
+}
+
+// GetAllStoresContext ...
+func (m *API) GetAllStoresContext(ctx context.Context, app model.App) ([]model.Store, error) {
+	m.record("GetAllStores")
+	if m.GetAllStoresFunc == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.GetAllStoresFunc(ctx, app)
+}
+
+// CreateStore ...
+func (m *API) CreateStore(opts model.StoreOptions, app model.App) (model.Store, error) {
+	return m.CreateStoreContext(context.Background(), opts, app)
+}
+
+// CreateStoreContext ...
+func (m *API) CreateStoreContext(ctx context.Context, opts model.StoreOptions, app model.App) (model.Store, error) {
+	m.record("CreateStore")
+	if m.CreateStoreFunc == nil {
+		return model.Store{}, ErrNotImplemented
+	}
+	return m.CreateStoreFunc(ctx, opts, app)
+}
+
+// DeleteStore ...
+func (m *API) DeleteStore(storeName string, app model.App) error {
+	return m.DeleteStoreContext(context.Background(), storeName, app)
+}
+
+// DeleteStoreContext ...
+func (m *API) DeleteStoreContext(ctx context.Context, storeName string, app model.App) error {
+	m.record("DeleteStore")
+	if m.DeleteStoreFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.DeleteStoreFunc(ctx, storeName, app)
+}
+
+// AddReleaseToGroup ...
+func (m *API) AddReleaseToGroup(g model.Group, releaseID int, opts model.ReleaseOptions) error {
+	return m.AddReleaseToGroupContext(context.Background(), g, releaseID, opts)
+}
+
+// AddReleaseToGroupContext ...
+func (m *API) AddReleaseToGroupContext(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error {
+	m.record("AddReleaseToGroup")
+	if m.AddReleaseToGroupFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.AddReleaseToGroupFunc(ctx, g, releaseID, opts)
+}
+
+// AddReleaseToStore ...
+func (m *API) AddReleaseToStore(s model.Store, releaseID int, opts model.ReleaseOptions) error {
+	return m.AddReleaseToStoreContext(context.Background(), s, releaseID, opts)
+}
+
+// AddReleaseToStoreContext ...
+func (m *API) AddReleaseToStoreContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error {
+	m.record("AddReleaseToStore")
+	if m.AddReleaseToStoreFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.AddReleaseToStoreFunc(ctx, s, releaseID, opts)
+}
+
+// WaitForStorePublish ...
+func (m *API) WaitForStorePublish(s model.Store, releaseID int, opts model.ReleaseOptions) (model.ReleaseDistributionStore, error) {
+	return m.WaitForStorePublishContext(context.Background(), s, releaseID, opts)
+}
+
+// WaitForStorePublishContext ...
+func (m *API) WaitForStorePublishContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) (model.ReleaseDistributionStore, error) {
+	m.record("WaitForStorePublish")
+	if m.WaitForStorePublishFunc == nil {
+		return model.ReleaseDistributionStore{}, ErrNotImplemented
+	}
+	return m.WaitForStorePublishFunc(ctx, s, releaseID, opts)
+}
+
+// AddTesterToRelease ...
+func (m *API) AddTesterToRelease(email string, releaseID int, opts model.ReleaseOptions) error {
+	return m.AddTesterToReleaseContext(context.Background(), email, releaseID, opts)
+}
+
+// AddTesterToReleaseContext ...
+func (m *API) AddTesterToReleaseContext(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error {
+	m.record("AddTesterToRelease")
+	if m.AddTesterToReleaseFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.AddTesterToReleaseFunc(ctx, email, releaseID, opts)
+}
+
+// RemoveReleaseFromGroup ...
+func (m *API) RemoveReleaseFromGroup(g model.Group, releaseID int, opts model.ReleaseOptions) error {
+	return m.RemoveReleaseFromGroupContext(context.Background(), g, releaseID, opts)
+}
+
+// RemoveReleaseFromGroupContext ...
+func (m *API) RemoveReleaseFromGroupContext(ctx context.Context, g model.Group, releaseID int, opts model.ReleaseOptions) error {
+	m.record("RemoveReleaseFromGroup")
+	if m.RemoveReleaseFromGroupFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.RemoveReleaseFromGroupFunc(ctx, g, releaseID, opts)
+}
+
+// RemoveReleaseFromStore ...
+func (m *API) RemoveReleaseFromStore(s model.Store, releaseID int, opts model.ReleaseOptions) error {
+	return m.RemoveReleaseFromStoreContext(context.Background(), s, releaseID, opts)
+}
+
+// RemoveReleaseFromStoreContext ...
+func (m *API) RemoveReleaseFromStoreContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) error {
+	m.record("RemoveReleaseFromStore")
+	if m.RemoveReleaseFromStoreFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.RemoveReleaseFromStoreFunc(ctx, s, releaseID, opts)
+}
+
+// RemoveTesterFromRelease ...
+func (m *API) RemoveTesterFromRelease(email string, releaseID int, opts model.ReleaseOptions) error {
+	return m.RemoveTesterFromReleaseContext(context.Background(), email, releaseID, opts)
+}
+
+// RemoveTesterFromReleaseContext ...
+func (m *API) RemoveTesterFromReleaseContext(ctx context.Context, email string, releaseID int, opts model.ReleaseOptions) error {
+	m.record("RemoveTesterFromRelease")
+	if m.RemoveTesterFromReleaseFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.RemoveTesterFromReleaseFunc(ctx, email, releaseID, opts)
+}
+
+// SetReleaseNoteOnRelease ...
+func (m *API) SetReleaseNoteOnRelease(releaseNote string, releaseID int, opts model.ReleaseOptions) error {
+	return m.SetReleaseNoteOnReleaseContext(context.Background(), releaseNote, releaseID, opts)
+}
+
+// SetReleaseNoteOnReleaseContext ...
+func (m *API) SetReleaseNoteOnReleaseContext(ctx context.Context, releaseNote string, releaseID int, opts model.ReleaseOptions) error {
+	m.record("SetReleaseNoteOnRelease")
+	if m.SetReleaseNoteOnReleaseFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.SetReleaseNoteOnReleaseFunc(ctx, releaseNote, releaseID, opts)
+}
+
+// UpdateRelease ...
+func (m *API) UpdateRelease(update model.ReleaseUpdate, releaseID int, opts model.ReleaseOptions) error {
+	return m.UpdateReleaseContext(context.Background(), update, releaseID, opts)
+}
+
+// UpdateReleaseContext ...
+func (m *API) UpdateReleaseContext(ctx context.Context, update model.ReleaseUpdate, releaseID int, opts model.ReleaseOptions) error {
+	m.record("UpdateRelease")
+	if m.UpdateReleaseFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.UpdateReleaseFunc(ctx, update, releaseID, opts)
+}
+
+// UploadSymbolToRelease ...
+func (m *API) UploadSymbolToRelease(filePath string, release model.Release, opts model.ReleaseOptions) error {
+	return m.UploadSymbolToReleaseContext(context.Background(), filePath, release, opts)
+}
+
+// UploadSymbolToReleaseContext ...
+func (m *API) UploadSymbolToReleaseContext(ctx context.Context, filePath string, release model.Release, opts model.ReleaseOptions) error {
+	m.record("UploadSymbolToRelease")
+	if m.UploadSymbolToReleaseFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.UploadSymbolToReleaseFunc(ctx, filePath, release, opts)
+}
+
+// CreateRelease ...
+func (m *API) CreateRelease(opts model.ReleaseOptions) (int, error) {
+	return m.CreateReleaseContext(context.Background(), opts)
+}
+
+// CreateReleaseContext ...
+func (m *API) CreateReleaseContext(ctx context.Context, opts model.ReleaseOptions) (int, error) {
+	m.record("CreateRelease")
+	if m.CreateReleaseFunc == nil {
+		return -1, ErrNotImplemented
+	}
+	return m.CreateReleaseFunc(ctx, opts)
+}
+
+// CreateReleaseWithResult ...
+func (m *API) CreateReleaseWithResult(opts model.ReleaseOptions) (model.CreateReleaseResult, error) {
+	return m.CreateReleaseWithResultContext(context.Background(), opts)
+}
+
+// CreateReleaseWithResultContext ...
+func (m *API) CreateReleaseWithResultContext(ctx context.Context, opts model.ReleaseOptions) (model.CreateReleaseResult, error) {
+	m.record("CreateReleaseWithResult")
+	if m.CreateReleaseWithResultFunc == nil {
+		return model.CreateReleaseResult{ReleaseID: -1}, ErrNotImplemented
+	}
+	return m.CreateReleaseWithResultFunc(ctx, opts)
+}
+
+// ResumeRelease ...
+func (m *API) ResumeRelease(opts model.ReleaseOptions) (int, error) {
+	return m.ResumeReleaseContext(context.Background(), opts)
+}
+
+// ResumeReleaseContext ...
+func (m *API) ResumeReleaseContext(ctx context.Context, opts model.ReleaseOptions) (int, error) {
+	m.record("ResumeRelease")
+	if m.ResumeReleaseFunc == nil {
+		return -1, ErrNotImplemented
+	}
+	return m.ResumeReleaseFunc(ctx, opts)
+}
+
+// ListReleases ...
+func (m *API) ListReleases(app model.App, opts model.ReleaseListOptions) ([]model.ReleaseSummary, error) {
+	return m.ListReleasesContext(context.Background(), app, opts)
+}
+
+// ListReleasesContext ...
+func (m *API) ListReleasesContext(ctx context.Context, app model.App, opts model.ReleaseListOptions) ([]model.ReleaseSummary, error) {
+	m.record("ListReleases")
+	if m.ListReleasesFunc == nil {
+		return nil, ErrNotImplemented
+	}
+	return m.ListReleasesFunc(ctx, app, opts)
+}
+
+// FindReleaseByVersion ...
+func (m *API) FindReleaseByVersion(app model.App, shortVersion, build string) (model.Release, error) {
+	return m.FindReleaseByVersionContext(context.Background(), app, shortVersion, build)
+}
+
+// FindReleaseByVersionContext ...
+func (m *API) FindReleaseByVersionContext(ctx context.Context, app model.App, shortVersion, build string) (model.Release, error) {
+	m.record("FindReleaseByVersion")
+	if m.FindReleaseByVersionFunc == nil {
+		return model.Release{}, ErrNotImplemented
+	}
+	return m.FindReleaseByVersionFunc(ctx, app, shortVersion, build)
+}
+
+// FindReleaseByHash ...
+func (m *API) FindReleaseByHash(app model.App, sha256 string, maxReleases int) (model.Release, error) {
+	return m.FindReleaseByHashContext(context.Background(), app, sha256, maxReleases)
+}
+
+// FindReleaseByHashContext ...
+func (m *API) FindReleaseByHashContext(ctx context.Context, app model.App, sha256 string, maxReleases int) (model.Release, error) {
+	m.record("FindReleaseByHash")
+	if m.FindReleaseByHashFunc == nil {
+		return model.Release{}, ErrNotImplemented
+	}
+	return m.FindReleaseByHashFunc(ctx, app, sha256, maxReleases)
+}
+
+// DeleteRelease ...
+func (m *API) DeleteRelease(app model.App, releaseID int) error {
+	return m.DeleteReleaseContext(context.Background(), app, releaseID)
+}
+
+// DeleteReleaseContext ...
+func (m *API) DeleteReleaseContext(ctx context.Context, app model.App, releaseID int) error {
+	m.record("DeleteRelease")
+	if m.DeleteReleaseFunc == nil {
+		return ErrNotImplemented
+	}
+	return m.DeleteReleaseFunc(ctx, app, releaseID)
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	defaultPollIntervalMin = 5 * time.Second
+	defaultPollIntervalMax = 10 * time.Second
+)
+
+// Option configures the Client and the API.
+type Option func(*config)
+
+type config struct {
+	logger           Logger
+	baseURL          string
+	concurrency      int
+	pollIntervalMin  time.Duration
+	pollIntervalMax  time.Duration
+	maxPollAttempts  int
+	httpClient       *http.Client
+	timeout          time.Duration
+	retryMax         int
+	progressReporter ProgressReporter
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		logger:          NewDefaultLogger(),
+		baseURL:         baseURL,
+		concurrency:     maxConcurrentChunkUploads,
+		pollIntervalMin: defaultPollIntervalMin,
+		pollIntervalMax: defaultPollIntervalMax,
+		retryMax:        -1,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// WithLogger sets the Logger used for progress messages and warnings, use NopLogger to disable logging.
+func WithLogger(logger Logger) Option {
+	return func(c *config) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
+// WithBaseURL overrides the AppCenter API URL, for example to target a proxy.
+func WithBaseURL(url string) Option {
+	return func(c *config) {
+		c.baseURL = url
+	}
+}
+
+// WithConcurrency sets the number of release chunks uploaded in parallel.
+func WithConcurrency(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+// WithPollInterval sets the wait between two release status checks after the upload,
+// by default a random interval between 5 and 10 seconds is used.
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *config) {
+		if interval > 0 {
+			c.pollIntervalMin = interval
+			c.pollIntervalMax = interval
+		}
+	}
+}
+
+// WithMaxPollAttempts sets how many times the release status is checked before giving up.
+func WithMaxPollAttempts(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.maxPollAttempts = n
+		}
+	}
+}
+
+// WithHTTPClient sets the http.Client the requests are sent with.
+// Its transport is wrapped to authenticate the requests, the given client is not modified.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *config) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets the time limit of a single request attempt, including reading the response.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetryMax sets how many times a failed request is retried by the http client.
+func WithRetryMax(n int) Option {
+	return func(c *config) {
+		if n >= 0 {
+			c.retryMax = n
+		}
+	}
+}
+
+// WithProgressReporter sets API.ProgressReporter.
+func WithProgressReporter(reporter ProgressReporter) Option {
+	return func(c *config) {
+		c.progressReporter = reporter
+	}
+}
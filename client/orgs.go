@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// GetOrgGroups ...
+func (api API) GetOrgGroups(orgName string) ([]model.Group, error) {
+	return api.GetOrgGroupsContext(context.Background(), orgName)
+}
+
+// GetOrgGroupsContext returns the distribution groups shared across the apps of the organization.
+func (api API) GetOrgGroupsContext(ctx context.Context, orgName string) ([]model.Group, error) {
+	var (
+		getURL      = fmt.Sprintf("%s/v0.1/orgs/%s/distribution_groups", api.baseURL, orgName)
+		getResponse []model.Group
+	)
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, newAPIError(http.MethodGet, getURL, statusCode)
+	}
+
+	return getResponse, nil
+}
+
+// GetOrgGroupByName ...
+func (api API) GetOrgGroupByName(groupName, orgName string) (model.Group, error) {
+	return api.GetOrgGroupByNameContext(context.Background(), groupName, orgName)
+}
+
+// GetOrgGroupByNameContext ...
+func (api API) GetOrgGroupByNameContext(ctx context.Context, groupName, orgName string) (model.Group, error) {
+	var (
+		getURL      = fmt.Sprintf("%s/v0.1/orgs/%s/distribution_groups/%s", api.baseURL, orgName, url.PathEscape(groupName))
+		getResponse model.Group
+	)
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
+	if err != nil {
+		return model.Group{}, err
+	}
+
+	if statusCode != http.StatusOK {
+		return model.Group{}, newAPIError(http.MethodGet, getURL, statusCode)
+	}
+
+	return getResponse, nil
+}
+
+// CreateOrgGroup ...
+func (api API) CreateOrgGroup(opts model.GroupOptions, orgName string) (model.Group, error) {
+	return api.CreateOrgGroupContext(context.Background(), opts, orgName)
+}
+
+// CreateOrgGroupContext ...
+func (api API) CreateOrgGroupContext(ctx context.Context, opts model.GroupOptions, orgName string) (model.Group, error) {
+	if strings.TrimSpace(opts.Name) == "" {
+		return model.Group{}, errors.New("group name is required")
+	}
+
+	var (
+		postURL      = fmt.Sprintf("%s/v0.1/orgs/%s/distribution_groups", api.baseURL, orgName)
+		postResponse model.Group
+	)
+
+	body, err := api.Client.MarshallContent(opts)
+	if err != nil {
+		return model.Group{}, err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, &postResponse)
+	if err != nil {
+		return model.Group{}, err
+	}
+
+	if statusCode != http.StatusCreated {
+		return model.Group{}, newAPIError(http.MethodPost, postURL, statusCode)
+	}
+
+	return postResponse, nil
+}
+
+// AddAppsToOrgGroup ...
+func (api API) AddAppsToOrgGroup(groupName string, appNames []string, orgName string) error {
+	return api.AddAppsToOrgGroupContext(context.Background(), groupName, appNames, orgName)
+}
+
+// AddAppsToOrgGroupContext attaches the apps of the organization to the shared group,
+// so their releases can be distributed to it.
+func (api API) AddAppsToOrgGroupContext(ctx context.Context, groupName string, appNames []string, orgName string) error {
+	type appRef struct {
+		Name string `json:"name"`
+	}
+
+	var (
+		postURL     = fmt.Sprintf("%s/v0.1/orgs/%s/distribution_groups/%s/apps", api.baseURL, orgName, url.PathEscape(groupName))
+		postRequest = struct {
+			Apps []appRef `json:"apps"`
+		}{}
+	)
+	for _, name := range appNames {
+		postRequest.Apps = append(postRequest.Apps, appRef{Name: name})
+	}
+
+	body, err := api.Client.MarshallContent(postRequest)
+	if err != nil {
+		return err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK && statusCode != http.StatusCreated && statusCode != http.StatusNoContent {
+		return newAPIError(http.MethodPost, postURL, statusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import "sync"
+
+// Phase ...
+type Phase string
+
+// Phases of an upload, reported in Progress.Phase.
+const (
+	PhaseMetadata  Phase = "metadata"
+	PhaseChunks    Phase = "chunks"
+	PhaseFinishing Phase = "finishing"
+	PhasePolling   Phase = "polling"
+	PhaseDone      Phase = "done"
+)
+
+// Progress describes the current state of an upload.
+// Symbol files are uploaded in a single chunk.
+type Progress struct {
+	Phase       Phase
+	BytesSent   int64
+	TotalBytes  int64
+	ChunksDone  int
+	ChunksTotal int
+	// PollAttempt is the number of the current release status check in PhasePolling.
+	PollAttempt int
+}
+
+// ProgressReporter receives the progress of CreateRelease, ResumeRelease and UploadSymbolToRelease.
+// Calls are never made concurrently.
+type ProgressReporter interface {
+	ReportProgress(Progress)
+}
+
+// ProgressReporterFunc adapts a function to the ProgressReporter interface.
+type ProgressReporterFunc func(Progress)
+
+// ReportProgress ...
+func (f ProgressReporterFunc) ReportProgress(p Progress) {
+	f(p)
+}
+
+func (api API) reportProgress(p Progress) {
+	if api.ProgressReporter != nil {
+		api.ProgressReporter.ReportProgress(p)
+	}
+}
+
+// chunkProgress aggregates the progress of the concurrent chunk uploads.
+type chunkProgress struct {
+	api      API
+	progress Progress
+	mu       sync.Mutex
+}
+
+func (p *chunkProgress) chunkDone(size int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.progress.ChunksDone++
+	p.progress.BytesSent += size
+	p.api.reportProgress(p.progress)
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// ListReleases ...
+func (api API) ListReleases(app model.App, opts model.ReleaseListOptions) ([]model.ReleaseSummary, error) {
+	return api.ListReleasesContext(context.Background(), app, opts)
+}
+
+// ListReleasesContext returns the releases of the app from the newest one.
+// AppCenter returns the whole listing at once, Offset and Limit are applied on the client side.
+func (api API) ListReleasesContext(ctx context.Context, app model.App, opts model.ReleaseListOptions) ([]model.ReleaseSummary, error) {
+	query := url.Values{}
+	if opts.PublishedOnly {
+		query.Set("published_only", "true")
+	}
+	if opts.Scope != "" {
+		query.Set("scope", opts.Scope)
+	}
+
+	var getURL string
+	if opts.GroupName != "" {
+		getURL = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_groups/%s/releases", api.baseURL, app.Owner, app.AppName, url.PathEscape(opts.GroupName))
+	} else {
+		getURL = fmt.Sprintf("%s/v0.1/apps/%s/%s/releases", api.baseURL, app.Owner, app.AppName)
+	}
+	if len(query) > 0 {
+		getURL += "?" + query.Encode()
+	}
+
+	var getResponse []model.ReleaseSummary
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, newAPIError(http.MethodGet, getURL, statusCode)
+	}
+
+	sort.SliceStable(getResponse, func(i, j int) bool {
+		return getResponse[i].ID > getResponse[j].ID
+	})
+
+	return pageReleases(getResponse, opts.Offset, opts.Limit), nil
+}
+
+func pageReleases(releases []model.ReleaseSummary, offset, limit int) []model.ReleaseSummary {
+	if offset >= len(releases) {
+		return []model.ReleaseSummary{}
+	}
+	if offset > 0 {
+		releases = releases[offset:]
+	}
+	if limit > 0 && limit < len(releases) {
+		releases = releases[:limit]
+	}
+
+	return releases
+}
+
+// FindReleaseByVersion ...
+func (api API) FindReleaseByVersion(app model.App, shortVersion, build string) (model.Release, error) {
+	return api.FindReleaseByVersionContext(context.Background(), app, shortVersion, build)
+}
+
+// FindReleaseByVersionContext returns the newest release with the given short version and build (version),
+// an empty build matches any build. ErrReleaseNotFound is returned if there is no such release.
+func (api API) FindReleaseByVersionContext(ctx context.Context, app model.App, shortVersion, build string) (model.Release, error) {
+	releases, err := api.ListReleasesContext(ctx, app, model.ReleaseListOptions{})
+	if err != nil {
+		return model.Release{}, err
+	}
+
+	for _, release := range releases {
+		if release.ShortVersion != shortVersion {
+			continue
+		}
+		if build != "" && release.Version != build {
+			continue
+		}
+
+		return api.GetAppReleaseDetailsContext(ctx, app, release.ID)
+	}
+
+	return model.Release{}, fmt.Errorf("%w: version: %s, build: %s", ErrReleaseNotFound, shortVersion, build)
+}
+
+// FindReleaseByHash ...
+func (api API) FindReleaseByHash(app model.App, sha256 string, maxReleases int) (model.Release, error) {
+	return api.FindReleaseByHashContext(context.Background(), app, sha256, maxReleases)
+}
+
+// FindReleaseByHashContext returns the newest release whose package hashes contain the given SHA-256 hex digest.
+// The listing does not contain the hashes, so the details of the releases are fetched one by one from the newest release:
+// a lookup costs one request per checked release. Only the maxReleases newest releases are checked:
+// zero checks the model.DefaultReuseLookupReleases newest releases, a negative value checks every release.
+// ErrReleaseNotFound is returned if there is no such release.
+func (api API) FindReleaseByHashContext(ctx context.Context, app model.App, sha256 string, maxReleases int) (model.Release, error) {
+	switch {
+	case maxReleases == 0:
+		maxReleases = model.DefaultReuseLookupReleases
+	case maxReleases < 0:
+		// no limit for the listing
+		maxReleases = 0
+	}
+
+	releases, err := api.ListReleasesContext(ctx, app, model.ReleaseListOptions{Limit: maxReleases})
+	if err != nil {
+		return model.Release{}, err
+	}
+
+	for _, summary := range releases {
+		release, err := api.GetAppReleaseDetailsContext(ctx, app, summary.ID)
+		if err != nil {
+			if IsNotFound(err) {
+				// deleted since the listing
+				continue
+			}
+			return model.Release{}, err
+		}
+
+		for _, hash := range release.PackageHashes {
+			if strings.EqualFold(hash, sha256) {
+				return release, nil
+			}
+		}
+	}
+
+	return model.Release{}, fmt.Errorf("%w: package hash: %s", ErrReleaseNotFound, sha256)
+}
+
+// DeleteRelease ...
+func (api API) DeleteRelease(app model.App, releaseID int) error {
+	return api.DeleteReleaseContext(context.Background(), app, releaseID)
+}
+
+// DeleteReleaseContext ...
+func (api API) DeleteReleaseContext(ctx context.Context, app model.App, releaseID int) error {
+	deleteURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/releases/%d", api.baseURL, app.Owner, app.AppName, releaseID)
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodDelete, deleteURL, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent {
+		return newAPIError(http.MethodDelete, deleteURL, statusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// uploadSession keeps the upload session in sync with its state file.
+// Without a path the session is only kept in memory.
+type uploadSession struct {
+	model.UploadSession
+
+	path string
+	mu   sync.Mutex
+}
+
+func newUploadSession(path string, session model.UploadSession) *uploadSession {
+	return &uploadSession{
+		UploadSession: session,
+		path:          path,
+	}
+}
+
+func loadUploadSession(path string) (*uploadSession, error) {
+	if path == "" {
+		return nil, errors.New("no upload session path provided")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload session: %w", err)
+	}
+
+	var session model.UploadSession
+	if err := json.Unmarshal(b, &session); err != nil {
+		return nil, fmt.Errorf("failed to parse upload session %s: %w", path, err)
+	}
+
+	if err := validateUploadSession(session); err != nil {
+		return nil, fmt.Errorf("invalid upload session %s: %w", path, err)
+	}
+
+	return newUploadSession(path, session), nil
+}
+
+// validateUploadSession checks that the chunk list covers the file, a malformed session would upload a partial file.
+func validateUploadSession(session model.UploadSession) error {
+	if session.ChunkSize <= 0 {
+		return fmt.Errorf("invalid chunk size: %d", session.ChunkSize)
+	}
+	if session.FileSize < 0 {
+		return fmt.Errorf("invalid file size: %d", session.FileSize)
+	}
+
+	chunkSize := int64(session.ChunkSize)
+	expected := (session.FileSize + chunkSize - 1) / chunkSize
+	if int64(len(session.ChunkList)) != expected {
+		return fmt.Errorf("chunk list has %d chunks, expected %d for file size %d and chunk size %d",
+			len(session.ChunkList), expected, session.FileSize, session.ChunkSize)
+	}
+
+	return nil
+}
+
+// save writes the session to a temporary file first, so an interruption never leaves a truncated state file behind.
+func (s *uploadSession) save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saveLocked()
+}
+
+func (s *uploadSession) saveLocked() error {
+	if s.path == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(s.UploadSession)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, b, 0600); err != nil {
+		return fmt.Errorf("failed to write upload session: %w", err)
+	}
+
+	return os.Rename(tmpPath, s.path)
+}
+
+func (s *uploadSession) chunkUploaded(chunkID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.UploadedChunks = append(s.UploadedChunks, chunkID)
+
+	return s.saveLocked()
+}
+
+// missingChunks returns the file chunks and their IDs which are not uploaded yet.
+func (s *uploadSession) missingChunks(fileChunks []*io.SectionReader) ([]*io.SectionReader, []int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	uploaded := map[int]bool{}
+	for _, id := range s.UploadedChunks {
+		uploaded[id] = true
+	}
+
+	var (
+		chunks []*io.SectionReader
+		ids    []int
+	)
+	for idx, id := range s.ChunkList {
+		if uploaded[id] || idx >= len(fileChunks) {
+			continue
+		}
+
+		chunks = append(chunks, fileChunks[idx])
+		ids = append(ids, id)
+	}
+
+	return chunks, ids
+}
+
+func (s *uploadSession) remove() error {
+	if s.path == "" {
+		return nil
+	}
+
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// GetAllStores ...
+func (api API) GetAllStores(app model.App) ([]model.Store, error) {
+	return api.GetAllStoresContext(context.Background(), app)
+}
+
+// GetAllStoresContext ...
+func (api API) GetAllStoresContext(ctx context.Context, app model.App) ([]model.Store, error) {
+	var (
+		getURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_stores", api.baseURL, app.Owner, app.AppName)
+		getResponse []model.Store
+	)
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodGet, getURL, nil, &getResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return nil, newAPIError(http.MethodGet, getURL, statusCode)
+	}
+
+	return getResponse, nil
+}
+
+// CreateStore ...
+func (api API) CreateStore(opts model.StoreOptions, app model.App) (model.Store, error) {
+	return api.CreateStoreContext(context.Background(), opts, app)
+}
+
+// CreateStoreContext ...
+func (api API) CreateStoreContext(ctx context.Context, opts model.StoreOptions, app model.App) (model.Store, error) {
+	if opts.Track == "" {
+		opts.Track = model.StoreTrackProduction
+	}
+	if err := opts.Validate(); err != nil {
+		return model.Store{}, err
+	}
+
+	var (
+		postURL      = fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_stores", api.baseURL, app.Owner, app.AppName)
+		postResponse model.Store
+	)
+
+	body, err := api.Client.MarshallContent(opts)
+	if err != nil {
+		return model.Store{}, err
+	}
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodPost, postURL, body, &postResponse)
+	if err != nil {
+		return model.Store{}, err
+	}
+
+	if statusCode != http.StatusCreated {
+		return model.Store{}, newAPIError(http.MethodPost, postURL, statusCode)
+	}
+
+	return postResponse, nil
+}
+
+// DeleteStore ...
+func (api API) DeleteStore(storeName string, app model.App) error {
+	return api.DeleteStoreContext(context.Background(), storeName, app)
+}
+
+// DeleteStoreContext ...
+func (api API) DeleteStoreContext(ctx context.Context, storeName string, app model.App) error {
+	deleteURL := fmt.Sprintf("%s/v0.1/apps/%s/%s/distribution_stores/%s", api.baseURL, app.Owner, app.AppName, url.PathEscape(storeName))
+
+	statusCode, err := api.Client.jsonRequest(ctx, http.MethodDelete, deleteURL, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent {
+		return newAPIError(http.MethodDelete, deleteURL, statusCode)
+	}
+
+	return nil
+}
+
+// WaitForStorePublish ...
+func (api API) WaitForStorePublish(s model.Store, releaseID int, opts model.ReleaseOptions) (model.ReleaseDistributionStore, error) {
+	return api.WaitForStorePublishContext(context.Background(), s, releaseID, opts)
+}
+
+// WaitForStorePublishContext polls the release until its store destination reaches a terminal publishing status,
+// with the poll interval and attempts of the release upload. A failed publishing is returned as *StorePublishError,
+// running out of attempts as ErrStorePublishTimeout.
+func (api API) WaitForStorePublishContext(ctx context.Context, s model.Store, releaseID int, opts model.ReleaseOptions) (model.ReleaseDistributionStore, error) {
+	var (
+		store    model.ReleaseDistributionStore
+		attempts = 1
+	)
+
+	for !api.maxAttemptsReached(attempts) {
+		release, err := api.GetAppReleaseDetailsContext(ctx, opts.App, releaseID)
+		if err != nil {
+			return store, err
+		}
+
+		var found bool
+		store, found = releaseStore(release, s)
+		if !found {
+			return store, fmt.Errorf("store %s on release %d: %w", s.Name, releaseID, ErrDestinationNotFound)
+		}
+
+		switch store.PublishingStatus {
+		case model.PublishingStatusPublished:
+			api.log().Info("Release published to store", F("release_id", releaseID), F("store", s.Name))
+			return store, nil
+		case model.PublishingStatusFailed:
+			return store, &StorePublishError{ReleaseID: releaseID, StoreID: store.ID, StoreName: s.Name, Status: store.PublishingStatus}
+		}
+
+		attempts++
+
+		sleepDuration := api.pollInterval()
+		api.log().Info("Waiting for the store publishing",
+			F("release_id", releaseID),
+			F("store", s.Name),
+			F("publishing_status", store.PublishingStatus),
+			F("wait", sleepDuration))
+
+		select {
+		case <-ctx.Done():
+			return store, ctx.Err()
+		case <-time.After(sleepDuration):
+		}
+	}
+
+	return store, fmt.Errorf("store %s after %d attempts, last status: %s: %w", s.Name, attempts-1, store.PublishingStatus, ErrStorePublishTimeout)
+}
+
+// releaseStore returns the store destination of the release, matched by ID or name.
+func releaseStore(release model.Release, s model.Store) (model.ReleaseDistributionStore, bool) {
+	for _, store := range release.DistributionStores {
+		if (s.ID != "" && store.ID == s.ID) || (s.ID == "" && store.Name == s.Name) {
+			return store, true
+		}
+	}
+
+	for _, d := range release.Destinations {
+		if d.DestinationType != "store" {
+			continue
+		}
+		if (s.ID != "" && d.ID == s.ID) || (s.ID == "" && d.Name == s.Name) {
+			return model.ReleaseDistributionStore{ID: d.ID, Name: d.Name, Type: d.Type, PublishingStatus: d.PublishingStatus}, true
+		}
+	}
+
+	return model.ReleaseDistributionStore{}, false
+}
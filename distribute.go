@@ -0,0 +1,68 @@
+package appcenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// UnknownGroupsError is returned by DistributeToGroups when some of the group names could not be resolved,
+// the release is not distributed to any group in this case.
+type UnknownGroupsError struct {
+	Names []string
+}
+
+// Error ...
+func (e *UnknownGroupsError) Error() string {
+	return fmt.Sprintf("unknown distribution group(s): %s", strings.Join(e.Names, ", "))
+}
+
+// DistributeToGroups is the all-or-nothing variant of AddGroupsToRelease regarding the group names:
+// every name is resolved first, and an *UnknownGroupsError lists all the unknown names before anything is distributed.
+// Then the release is distributed to every group, a failing group does not stop the others.
+func (r ReleaseAPI) DistributeToGroups(groupNames []string) (RedistributeReport, error) {
+	return r.DistributeToGroupsContext(context.Background(), groupNames)
+}
+
+// DistributeToGroupsContext ...
+func (r ReleaseAPI) DistributeToGroupsContext(ctx context.Context, groupNames []string) (RedistributeReport, error) {
+	var (
+		report  RedistributeReport
+		groups  []model.Group
+		unknown []string
+	)
+
+	for _, name := range nonEmpty(groupNames) {
+		group, err := resolveGroup(ctx, r.API, r.ReleaseOptions.App, name)
+		switch {
+		case client.IsNotFound(err):
+			unknown = append(unknown, name)
+		case err != nil:
+			return report, fmt.Errorf("failed to resolve distribution group %s: %w", name, err)
+		default:
+			groups = append(groups, group)
+		}
+	}
+	if len(unknown) > 0 {
+		return report, &UnknownGroupsError{Names: unknown}
+	}
+
+	for _, group := range groups {
+		result := DestinationResult{Type: DestinationGroup, Name: group.Name}
+		if r.hasDestination(DestinationGroup, group.ID, group.Name) {
+			result.Skipped = true
+		} else {
+			result.Skipped, result.Err = alreadyDistributed(r.API.AddReleaseToGroupContext(ctx, group, r.Release.ID, r.ReleaseOptions))
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	if err := report.Err(); err != nil {
+		return report, fmt.Errorf("failed to distribute release %d to %d group(s): %w", r.Release.ID, len(report.Failed()), err)
+	}
+
+	return report, nil
+}
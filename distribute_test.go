@@ -0,0 +1,44 @@
+package appcenter
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestDistributeToGroups(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.AddGroup(testApp, model.Group{Name: "qa"})
+	server.AddGroup(testApp, model.Group{Name: "beta"})
+	server.AddOrgGroup(testApp.Owner, model.Group{Name: "everyone"}, testApp.AppName)
+	release := server.AddRelease(testApp, model.Release{})
+	app := newTestAppAPI(server)
+	r := CreateReleaseAPI(app.API, release, app.ReleaseOptions)
+
+	_, err := r.DistributeToGroups([]string{"qa", "missing", "beta", "gone"})
+	var unknownErr *UnknownGroupsError
+	if !errors.As(err, &unknownErr) || !reflect.DeepEqual(unknownErr.Names, []string{"missing", "gone"}) {
+		t.Fatalf("Unknown groups error with both missing names expected, got: %v", err)
+	}
+	if got, _ := server.Release(testApp, release.ID); len(got.DistributionGroups) != 0 {
+		t.Fatalf("Release should not be distributed, got: %+v", got.DistributionGroups)
+	}
+
+	server.FailRequests(http.MethodPost, "/v0.1/apps/owner/app/releases/", http.StatusInternalServerError, 1)
+	report, err := r.DistributeToGroups([]string{"qa", "beta", "everyone"})
+	if err == nil {
+		t.Fatal("Error expected for the failing group")
+	}
+	if len(report.Failed()) != 1 || report.Failed()[0].Name != "qa" || len(report.Succeeded()) != 2 {
+		t.Fatalf("Only qa should fail, got: %+v", report.Results)
+	}
+	if got, _ := server.Release(testApp, release.ID); len(got.DistributionGroups) != 2 {
+		t.Fatalf("Release should be distributed to 2 groups, got: %+v", got.DistributionGroups)
+	}
+}
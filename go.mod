@@ -5,10 +5,10 @@ go 1.20
 require (
 	github.com/bitrise-io/go-utils v1.0.8
 	github.com/hashicorp/go-retryablehttp v0.7.1
+	golang.org/x/sync v0.3.0
 )
 
 require (
 	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
 	github.com/stretchr/testify v1.8.1 // indirect
-	golang.org/x/sync v0.3.0 // indirect
 )
@@ -0,0 +1,52 @@
+package appcenter
+
+import (
+	"context"
+
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// ResolveGroup looks up a distribution group by name. App-scoped groups take precedence,
+// the shared groups of the app owner organization are only checked when the app has no group with the name.
+func (a AppAPI) ResolveGroup(name string) (model.Group, error) {
+	return resolveGroup(context.Background(), a.API, a.ReleaseOptions.App, name)
+}
+
+// OrgGroups returns the shared distribution groups of the app owner organization.
+func (a AppAPI) OrgGroups() ([]model.Group, error) {
+	return a.API.GetOrgGroups(a.ReleaseOptions.App.Owner)
+}
+
+// OrgGroup ...
+func (a AppAPI) OrgGroup(name string) (model.Group, error) {
+	return a.API.GetOrgGroupByName(name, a.ReleaseOptions.App.Owner)
+}
+
+// CreateOrgGroup creates a shared distribution group in the app owner organization.
+func (a AppAPI) CreateOrgGroup(opts model.GroupOptions) (model.Group, error) {
+	return a.API.CreateOrgGroup(opts, a.ReleaseOptions.App.Owner)
+}
+
+// AttachToOrgGroup attaches the app to the shared distribution group with the given name.
+func (a AppAPI) AttachToOrgGroup(name string) error {
+	return a.API.AddAppsToOrgGroup(name, []string{a.ReleaseOptions.App.AppName}, a.ReleaseOptions.App.Owner)
+}
+
+func resolveGroup(ctx context.Context, api client.AppCenterAPI, app model.App, name string) (model.Group, error) {
+	group, err := api.GetGroupByNameContext(ctx, name, app)
+	if !client.IsNotFound(err) {
+		return group, err
+	}
+
+	orgGroup, orgErr := api.GetOrgGroupByNameContext(ctx, name, app.Owner)
+	if client.IsNotFound(orgErr) {
+		// the owner may be a user instead of an organization, report the app-scoped lookup error
+		return model.Group{}, err
+	}
+	if orgErr != nil {
+		return model.Group{}, orgErr
+	}
+
+	return orgGroup, nil
+}
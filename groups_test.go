@@ -0,0 +1,113 @@
+package appcenter
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestAddGroupsToReleaseWithOrgGroups(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	appBeta := server.AddGroup(testApp, model.Group{Name: "beta"})
+	server.AddOrgGroup(testApp.Owner, model.Group{Name: "beta"}, testApp.AppName)
+	everyone := server.AddOrgGroup(testApp.Owner, model.Group{Name: "everyone"}, testApp.AppName)
+	server.AddOrgGroup(testApp.Owner, model.Group{Name: "partners"})
+	release := server.AddRelease(testApp, model.Release{})
+	app := newTestAppAPI(server)
+	r := CreateReleaseAPI(app.API, release, app.ReleaseOptions)
+
+	if err := r.AddGroupsToRelease([]string{"beta", "everyone"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	got, _ := server.Release(testApp, release.ID)
+	if len(got.DistributionGroups) != 2 || got.DistributionGroups[0].ID != appBeta.ID || got.DistributionGroups[1].ID != everyone.ID {
+		t.Fatalf("App beta group and shared everyone group expected, got: %+v", got.DistributionGroups)
+	}
+
+	if err := r.AddGroupsToRelease([]string{"partners"}); !client.IsNotFound(err) {
+		t.Fatalf("Not found expected for a shared group the app is not attached to, got: %v", err)
+	}
+	if err := app.AttachToOrgGroup("partners"); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.AddGroupsToRelease([]string{"partners"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	if _, err := app.ResolveGroup("missing"); !client.IsNotFound(err) {
+		t.Fatalf("Not found expected, got: %v", err)
+	}
+}
+
+func TestAddGroupsToReleaseEscapesOrgGroupNames(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.AddOrgGroup(testApp.Owner, model.Group{Name: "partners/eu"})
+	release := server.AddRelease(testApp, model.Release{})
+	app := newTestAppAPI(server)
+	r := CreateReleaseAPI(app.API, release, app.ReleaseOptions)
+
+	if err := app.AttachToOrgGroup("partners/eu"); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.AddGroupsToRelease([]string{"partners/eu"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	got, _ := server.Release(testApp, release.ID)
+	if len(got.DistributionGroups) != 1 || got.DistributionGroups[0].Name != "partners/eu" {
+		t.Fatalf("Shared partners/eu group expected, got: %+v", got.DistributionGroups)
+	}
+}
+
+func TestOrgGroups(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	app := newTestAppAPI(server)
+
+	created, err := app.CreateOrgGroup(model.GroupOptions{Name: "shared", IsPublic: true})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	group, err := app.OrgGroup("shared")
+	if err != nil || group.ID != created.ID || !group.IsPublic {
+		t.Fatalf("Created group expected, got: %+v, %v", group, err)
+	}
+
+	groups, err := app.OrgGroups()
+	if err != nil || len(groups) != 1 {
+		t.Fatalf("One shared group expected, got: %+v, %v", groups, err)
+	}
+}
+
+func TestResolveGroupReportsOrgLookupFailure(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	server.AddGroup(testApp, model.Group{Name: "qa"})
+	server.AddOrgGroup(testApp.Owner, model.Group{Name: "everyone"}, testApp.AppName)
+	release := server.AddRelease(testApp, model.Release{})
+	app := newTestAppAPI(server)
+	r := CreateReleaseAPI(app.API, release, app.ReleaseOptions)
+
+	server.FailRequests(http.MethodGet, "/v0.1/orgs/", http.StatusInternalServerError, 1)
+	_, err := r.DistributeToGroups([]string{"qa", "everyone"})
+
+	var unknownErr *UnknownGroupsError
+	if err == nil || errors.As(err, &unknownErr) || client.IsNotFound(err) {
+		t.Fatalf("Org lookup failure expected, got: %v", err)
+	}
+	if got, _ := server.Release(testApp, release.ID); len(got.DistributionGroups) != 0 {
+		t.Fatalf("Release should not be distributed, got: %+v", got.DistributionGroups)
+	}
+}
@@ -9,3 +9,44 @@ type Group struct {
 	IsPublic    bool   `json:"is_public"`
 	Error       Error  `json:"error"`
 }
+
+// GroupOptions ...
+type GroupOptions struct {
+	Name string `json:"name"`
+	// DisplayName defaults to Name.
+	DisplayName string `json:"display_name,omitempty"`
+	// IsPublic allows anyone with the install link to download the releases of the group.
+	IsPublic bool `json:"is_public"`
+}
+
+// GroupUpdate lists the group fields to change, nil fields are left untouched.
+type GroupUpdate struct {
+	// Name renames the group.
+	Name        *string `json:"name,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+}
+
+// GroupMember ...
+type GroupMember struct {
+	ID          string `json:"id"`
+	Email       string `json:"email"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// InvitePending is set until the invited user accepts the invitation.
+	InvitePending bool `json:"invite_pending"`
+}
+
+// GroupMemberResult is the outcome of adding one email to a distribution group.
+type GroupMemberResult struct {
+	UserEmail     string `json:"user_email"`
+	Status        int    `json:"status"`
+	Code          string `json:"code"`
+	Message       string `json:"message"`
+	InvitePending bool   `json:"invite_pending"`
+}
+
+// Succeeded reports whether the email was added to the group.
+func (r GroupMemberResult) Succeeded() bool {
+	return r.Status >= 200 && r.Status < 300
+}
@@ -9,4 +9,27 @@ type ReleaseOptions struct {
 	NotifyTesters bool
 	FilePath      string
 	App           App
+	// UploadSessionPath is the file the upload session is persisted to, if set.
+	// An interrupted upload can be continued from this file with ResumeRelease.
+	UploadSessionPath string
+	// ReuseExistingRelease skips the upload if the app already has a release with the SHA-256 hash of the file.
+	// The release listing has no hashes, so every checked release costs a release details request before the upload,
+	// see ReuseLookupReleases.
+	ReuseExistingRelease bool
+	// ReuseLookupReleases is the number of newest releases checked by ReuseExistingRelease, it is passed to FindReleaseByHash as is:
+	// zero checks the DefaultReuseLookupReleases newest releases, a negative value checks every release of the app.
+	ReuseLookupReleases int
+}
+
+// DefaultReuseLookupReleases is the number of newest releases checked by FindReleaseByHash and ReuseExistingRelease
+// when the limit is zero.
+const DefaultReuseLookupReleases = 20
+
+// CreateReleaseResult ...
+type CreateReleaseResult struct {
+	ReleaseID int
+	// Reused is true if an existing release with the same package hash was returned instead of uploading the file.
+	Reused bool
+	// PackageHash is the SHA-256 hash of the file, set only when ReuseExistingRelease is enabled.
+	PackageHash string
 }
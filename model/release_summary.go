@@ -0,0 +1,31 @@
+package model
+
+// ReleaseSummary is the lightweight release representation returned by the release listing.
+type ReleaseSummary struct {
+	ID                 int                        `json:"id"`
+	Version            string                     `json:"version"`
+	ShortVersion       string                     `json:"short_version"`
+	Origin             string                     `json:"origin"`
+	UploadedAt         string                     `json:"uploaded_at"`
+	MandatoryUpdate    bool                       `json:"mandatory_update"`
+	Enabled            bool                       `json:"enabled"`
+	IsExternalBuild    bool                       `json:"is_external_build"`
+	DistributionGroups []ReleaseDistributionGroup `json:"distribution_groups"`
+	DistributionStores []ReleaseDistributionStore `json:"distribution_stores"`
+	Destinations       []ReleaseDestination       `json:"destinations"`
+	Build              ReleaseBuild               `json:"build"`
+}
+
+// ReleaseListOptions filters and pages the release listing.
+type ReleaseListOptions struct {
+	// PublishedOnly lists only the releases which were distributed to a destination.
+	PublishedOnly bool
+	// Scope limits the listing, for example to the releases available for the caller as a "tester".
+	Scope string
+	// GroupName lists only the releases distributed to the given distribution group.
+	GroupName string
+	// Offset skips the given number of releases, the listing is ordered from the newest release.
+	Offset int
+	// Limit is the maximum number of returned releases, zero means no limit.
+	Limit int
+}
@@ -1,22 +1,97 @@
 package model
 
+import (
+	"errors"
+	"fmt"
+)
+
+// Distribution store types.
+const (
+	StoreTypeGooglePlay = "googleplay"
+	StoreTypeApple      = "apple"
+	StoreTypeIntune     = "intune"
+)
+
+// Distribution store tracks.
+const (
+	StoreTrackProduction         = "production"
+	StoreTrackAlpha              = "alpha"
+	StoreTrackBeta               = "beta"
+	StoreTrackInternal           = "internal"
+	StoreTrackTestflightInternal = "testflight-internal"
+	StoreTrackTestflightExternal = "testflight-external"
+)
+
+// storeTracks lists the tracks available for each store type.
+var storeTracks = map[string][]string{
+	StoreTypeGooglePlay: {StoreTrackProduction, StoreTrackAlpha, StoreTrackBeta, StoreTrackInternal},
+	StoreTypeApple:      {StoreTrackProduction, StoreTrackTestflightInternal, StoreTrackTestflightExternal},
+	StoreTypeIntune:     {StoreTrackProduction},
+}
+
 // Store ...
 type Store struct {
-	ID            string `json:"id"`
-	Name          string `json:"name"`
-	Type          string `json:"type"`
-	Track         string `json:"track"`
-	IntuneDetails struct {
-		TargetAudience struct {
-			Name string `json:"name"`
-			ID   string `json:"id"`
-		} `json:"target_audience"`
-		AppCategory struct {
-			Name string `json:"name"`
-			ID   string `json:"id"`
-		} `json:"app_category"`
-	} `json:"intune_details"`
+	ID                  string        `json:"id"`
+	Name                string        `json:"name"`
+	Type                string        `json:"type"`
+	Track               string        `json:"track"`
+	IntuneDetails       IntuneDetails `json:"intune_details"`
+	ServiceConnectionID string        `json:"service_connection_id"`
+	CreatedBy           string        `json:"created_by"`
+	Error               Error         `json:"error"`
+}
+
+// IntuneDetails ...
+type IntuneDetails struct {
+	TargetAudience IntuneReference `json:"target_audience"`
+	AppCategory    IntuneReference `json:"app_category"`
+}
+
+// IntuneReference ...
+type IntuneReference struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// StoreOptions describes a new distribution store destination.
+type StoreOptions struct {
+	Name string `json:"name"`
+	// Type is one of StoreTypeGooglePlay, StoreTypeApple or StoreTypeIntune.
+	Type string `json:"type"`
+	// Track needs to be available for the store type, for example StoreTrackBeta for Google Play
+	// or StoreTrackTestflightInternal for Apple. Defaults to StoreTrackProduction.
+	Track string `json:"track,omitempty"`
+	// ServiceConnectionID is the ID of the store account connection the releases are published with.
 	ServiceConnectionID string `json:"service_connection_id"`
-	CreatedBy           string `json:"created_by"`
-	Error               Error  `json:"error"`
+	// IntuneDetails is required for StoreTypeIntune only.
+	IntuneDetails *IntuneDetails `json:"intune_details,omitempty"`
+}
+
+// Validate checks the store type and track combination and the required fields.
+func (o StoreOptions) Validate() error {
+	if o.Name == "" {
+		return errors.New("store name is required")
+	}
+	if o.ServiceConnectionID == "" {
+		return errors.New("store service connection ID is required")
+	}
+
+	tracks, ok := storeTracks[o.Type]
+	if !ok {
+		return fmt.Errorf("unknown store type: %s", o.Type)
+	}
+	if o.Type == StoreTypeIntune && o.IntuneDetails == nil {
+		return errors.New("intune details are required for intune stores")
+	}
+
+	if o.Track == "" {
+		return nil
+	}
+	for _, track := range tracks {
+		if track == o.Track {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("track %s is not available for %s stores", o.Track, o.Type)
 }
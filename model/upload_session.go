@@ -0,0 +1,18 @@
+package model
+
+// UploadSession is the persisted state of a release upload, used to resume an interrupted upload.
+type UploadSession struct {
+	UploadID        string `json:"upload_id"`
+	PackageAssetID  string `json:"package_asset_id"`
+	Token           string `json:"token"`
+	UploadDomain    string `json:"upload_domain"`
+	URLEncodedToken string `json:"url_encoded_token"`
+	FilePath        string `json:"file_path"`
+	FileSize        int64  `json:"file_size"`
+	FileSHA256      string `json:"file_sha256"`
+	ChunkSize       int    `json:"chunk_size"`
+	ChunkList       []int  `json:"chunk_list"`
+	UploadedChunks  []int  `json:"uploaded_chunks"`
+	UploadFinished  bool   `json:"upload_finished"`
+	ReleasePatched  bool   `json:"release_patched"`
+}
@@ -0,0 +1,112 @@
+package appcenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// ErrReleaseDisabled is returned when the release to promote is disabled.
+var ErrReleaseDisabled = errors.New("release is disabled")
+
+// Promotion describes one step of a promotion pipeline, for example from the "QA" group to the "Beta" group.
+type Promotion struct {
+	// FromGroup is the distribution group whose latest release is promoted.
+	FromGroup string
+	// ToGroups are the names of the distribution groups the release is promoted to.
+	ToGroups []string
+	// ToStores are the names of the distribution stores (store tracks) the release is promoted to.
+	ToStores []string
+}
+
+// PromotionReport ...
+type PromotionReport struct {
+	Promotion Promotion
+	Release   model.Release
+	RedistributeReport
+}
+
+// String renders the report in a human readable format.
+func (r PromotionReport) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Promoted release %d: %s (%s) from %s\n", r.Release.ID, r.Release.ShortVersion, r.Release.Version, r.Promotion.FromGroup)
+	for _, result := range r.Results {
+		switch {
+		case result.Err != nil:
+			fmt.Fprintf(&b, "- %s %s: failed: %v\n", result.Type, result.Name, result.Err)
+		case result.Skipped:
+			fmt.Fprintf(&b, "- %s %s: already present\n", result.Type, result.Name)
+		default:
+			fmt.Fprintf(&b, "- %s %s: added\n", result.Type, result.Name)
+		}
+	}
+
+	return b.String()
+}
+
+// Promote distributes the latest release of the Promotion.FromGroup group to the target groups and stores.
+// The release needs to be enabled, targets the release is already present in are skipped.
+func (a AppAPI) Promote(p Promotion) (PromotionReport, error) {
+	return a.PromoteContext(context.Background(), p)
+}
+
+// PromoteContext ...
+func (a AppAPI) PromoteContext(ctx context.Context, p Promotion) (PromotionReport, error) {
+	report := PromotionReport{Promotion: p}
+
+	if strings.TrimSpace(p.FromGroup) == "" {
+		return report, errors.New("promotion source group is not set")
+	}
+	if len(nonEmpty(p.ToGroups)) == 0 && len(nonEmpty(p.ToStores)) == 0 {
+		return report, errors.New("promotion has no target group or store")
+	}
+
+	releases, err := a.API.ListReleasesContext(ctx, a.ReleaseOptions.App, model.ReleaseListOptions{GroupName: p.FromGroup, Limit: 1})
+	if err != nil {
+		return report, fmt.Errorf("failed to list releases of group %s: %w", p.FromGroup, err)
+	}
+	if len(releases) == 0 {
+		return report, fmt.Errorf("group %s: %w", p.FromGroup, client.ErrReleaseNotFound)
+	}
+
+	r, err := a.ReleaseContext(ctx, releases[0].ID)
+	if err != nil {
+		return report, err
+	}
+	report.Release = r.Release
+
+	if !r.Release.Enabled {
+		return report, fmt.Errorf("release %d: %w", r.Release.ID, ErrReleaseDisabled)
+	}
+
+	report.RedistributeReport = r.RedistributeContext(ctx, Destinations{Groups: p.ToGroups, Stores: p.ToStores})
+	if err := report.Err(); err != nil {
+		return report, fmt.Errorf("failed to promote release %d: %w", r.Release.ID, err)
+	}
+
+	return report, nil
+}
+
+// PromoteAll runs the promotions in order and stops at the first failing one.
+func (a AppAPI) PromoteAll(promotions []Promotion) ([]PromotionReport, error) {
+	return a.PromoteAllContext(context.Background(), promotions)
+}
+
+// PromoteAllContext ...
+func (a AppAPI) PromoteAllContext(ctx context.Context, promotions []Promotion) ([]PromotionReport, error) {
+	reports := make([]PromotionReport, 0, len(promotions))
+	for _, p := range promotions {
+		report, err := a.PromoteContext(ctx, p)
+		reports = append(reports, report)
+		if err != nil {
+			return reports, err
+		}
+	}
+
+	return reports, nil
+}
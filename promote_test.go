@@ -0,0 +1,82 @@
+package appcenter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestPromote(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	internal := server.AddGroup(testApp, model.Group{Name: "internal"})
+	beta := server.AddGroup(testApp, model.Group{Name: "beta"})
+	server.AddStore(testApp, model.Store{Name: "production", Type: "googleplay"})
+	inGroups := func(groups ...model.Group) []model.ReleaseDistributionGroup {
+		var result []model.ReleaseDistributionGroup
+		for _, g := range groups {
+			result = append(result, model.ReleaseDistributionGroup{ID: g.ID, Name: g.Name})
+		}
+		return result
+	}
+	server.AddRelease(testApp, model.Release{Enabled: true, DistributionGroups: inGroups(internal, beta)})
+	release := server.AddRelease(testApp, model.Release{Enabled: true, DistributionGroups: inGroups(internal)})
+	server.AddRelease(testApp, model.Release{Enabled: true})
+	app := newTestAppAPI(server)
+
+	reports, err := app.PromoteAll([]Promotion{
+		{FromGroup: "internal", ToGroups: []string{"beta"}},
+		{FromGroup: "beta", ToStores: []string{"production"}},
+	})
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if len(reports) != 2 || reports[0].Release.ID != release.ID || reports[1].Release.ID != release.ID {
+		t.Fatalf("Release %d should be promoted in both steps, got: %+v", release.ID, reports)
+	}
+
+	got, _ := server.Release(testApp, release.ID)
+	if len(got.DistributionGroups) != 2 || len(got.DistributionStores) != 1 {
+		t.Fatalf("Release should be in 2 groups and a store, got: %+v", got)
+	}
+
+	report, err := app.Promote(Promotion{FromGroup: "internal", ToGroups: []string{"beta"}})
+	if err != nil || len(report.Results) != 1 || !report.Results[0].Skipped {
+		t.Fatalf("Already promoted release should be skipped, got: %+v, %v", report, err)
+	}
+
+	if err := app.API.UpdateRelease(model.ReleaseUpdate{Enabled: new(bool)}, release.ID, app.ReleaseOptions); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if _, err := app.Promote(Promotion{FromGroup: "internal", ToGroups: []string{"beta"}}); !errors.Is(err, ErrReleaseDisabled) {
+		t.Fatalf("ErrReleaseDisabled expected, got: %v", err)
+	}
+}
+
+func TestPromoteAllContextCancelled(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	app := newTestAppAPI(server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reports, err := app.PromoteAllContext(ctx, []Promotion{
+		{FromGroup: "internal", ToGroups: []string{"beta"}},
+		{FromGroup: "beta", ToGroups: []string{"public"}},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("context.Canceled expected, got: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("Only the first promotion expected to run, got: %d", len(reports))
+	}
+	if requests := server.RequestCount("GET", "/v0.1/apps/"); requests != 0 {
+		t.Fatalf("No requests expected, got: %d", requests)
+	}
+}
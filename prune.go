@@ -0,0 +1,155 @@
+package appcenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// RetentionPolicy describes the releases kept by PruneReleases, every other release is deleted.
+type RetentionPolicy struct {
+	// KeepLastPerBranch keeps the given number of newest releases of every branch (Release.Build.BranchName),
+	// releases without branch information count as one branch.
+	KeepLastPerBranch int
+	// KeepStoreReleases keeps every release distributed to a store destination.
+	KeepStoreReleases bool
+	// KeepNewerThan keeps the releases uploaded within the given duration, zero disables the age check.
+	KeepNewerThan time.Duration
+}
+
+// PruneFailure ...
+type PruneFailure struct {
+	Release model.ReleaseSummary
+	Err     error
+}
+
+// PruneReport ...
+type PruneReport struct {
+	DryRun bool
+	Kept   []model.ReleaseSummary
+	// Deleted contains the deleted releases, or the releases which would be deleted in dry-run mode.
+	Deleted []model.ReleaseSummary
+	Failed  []PruneFailure
+}
+
+// String renders the report in a human readable format, used as the dry-run output.
+func (r PruneReport) String() string {
+	var b strings.Builder
+
+	verb := "Deleted"
+	if r.DryRun {
+		verb = "Would delete"
+	}
+
+	fmt.Fprintf(&b, "%s %d release(s), keeping %d release(s)\n", verb, len(r.Deleted), len(r.Kept))
+	for _, release := range r.Deleted {
+		fmt.Fprintf(&b, "- %s\n", describeRelease(release))
+	}
+	for _, failure := range r.Failed {
+		fmt.Fprintf(&b, "Failed to delete %s: %v\n", describeRelease(failure.Release), failure.Err)
+	}
+
+	return b.String()
+}
+
+func describeRelease(r model.ReleaseSummary) string {
+	branch := r.Build.BranchName
+	if branch == "" {
+		branch = "-"
+	}
+
+	return fmt.Sprintf("release %d: %s (%s), branch: %s, uploaded at: %s", r.ID, r.ShortVersion, r.Version, branch, r.UploadedAt)
+}
+
+// PruneReleases deletes the releases of the app not kept by the retention policy.
+// In dry-run mode nothing is deleted, the report lists the releases which would be deleted.
+func (a AppAPI) PruneReleases(policy RetentionPolicy, dryRun bool) (PruneReport, error) {
+	return a.PruneReleasesContext(context.Background(), policy, dryRun)
+}
+
+// PruneReleasesContext ...
+func (a AppAPI) PruneReleasesContext(ctx context.Context, policy RetentionPolicy, dryRun bool) (PruneReport, error) {
+	if policy.KeepLastPerBranch <= 0 && !policy.KeepStoreReleases && policy.KeepNewerThan <= 0 {
+		return PruneReport{}, errors.New("retention policy would delete every release, set at least one rule")
+	}
+
+	releases, err := a.API.ListReleasesContext(ctx, a.ReleaseOptions.App, model.ReleaseListOptions{})
+	if err != nil {
+		return PruneReport{}, err
+	}
+
+	report := PruneReport{DryRun: dryRun}
+	now := time.Now()
+	keptPerBranch := map[string]int{}
+
+	// the listing is ordered from the newest release
+	for _, release := range releases {
+		if policy.keeps(release, keptPerBranch, now) {
+			report.Kept = append(report.Kept, release)
+			continue
+		}
+
+		if !dryRun {
+			if err := a.API.DeleteReleaseContext(ctx, a.ReleaseOptions.App, release.ID); err != nil {
+				report.Failed = append(report.Failed, PruneFailure{Release: release, Err: err})
+				continue
+			}
+		}
+
+		report.Deleted = append(report.Deleted, release)
+	}
+
+	if len(report.Failed) > 0 {
+		errs := make([]error, 0, len(report.Failed))
+		for _, failure := range report.Failed {
+			errs = append(errs, fmt.Errorf("release %d: %w", failure.Release.ID, failure.Err))
+		}
+
+		return report, fmt.Errorf("failed to delete %d release(s): %w", len(report.Failed), errors.Join(errs...))
+	}
+
+	return report, nil
+}
+
+// keeps needs to be called with the releases from the newest one, as it counts the kept releases per branch.
+func (p RetentionPolicy) keeps(release model.ReleaseSummary, keptPerBranch map[string]int, now time.Time) bool {
+	keep := false
+
+	branch := release.Build.BranchName
+	if keptPerBranch[branch] < p.KeepLastPerBranch {
+		keptPerBranch[branch]++
+		keep = true
+	}
+
+	if p.KeepStoreReleases && inStore(release) {
+		keep = true
+	}
+
+	if p.KeepNewerThan > 0 {
+		uploadedAt, err := time.Parse(time.RFC3339, release.UploadedAt)
+		if err != nil || now.Sub(uploadedAt) < p.KeepNewerThan {
+			// releases with unknown age are kept to be on the safe side
+			keep = true
+		}
+	}
+
+	return keep
+}
+
+func inStore(release model.ReleaseSummary) bool {
+	if len(release.DistributionStores) > 0 {
+		return true
+	}
+
+	for _, destination := range release.Destinations {
+		if destination.DestinationType == "store" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package appcenter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestPruneReleases(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	old := time.Now().Add(-30 * 24 * time.Hour).Format(time.RFC3339)
+	recent := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	for _, release := range []model.Release{
+		{ID: 1, UploadedAt: old, Build: model.ReleaseBuild{BranchName: "main"}},
+		{ID: 2, UploadedAt: old, Build: model.ReleaseBuild{BranchName: "main"}, DistributionStores: []model.ReleaseDistributionStore{{ID: "store"}}},
+		{ID: 3, UploadedAt: old, Build: model.ReleaseBuild{BranchName: "feature"}},
+		{ID: 4, UploadedAt: old, Build: model.ReleaseBuild{BranchName: "main"}},
+		{ID: 5, UploadedAt: recent, Build: model.ReleaseBuild{BranchName: "main"}},
+	} {
+		server.AddRelease(testApp, release)
+	}
+
+	app := newTestAppAPI(server)
+	policy := RetentionPolicy{KeepLastPerBranch: 1, KeepStoreReleases: true, KeepNewerThan: 7 * 24 * time.Hour}
+
+	report, err := app.PruneReleases(policy, true)
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if len(report.Deleted) != 2 || report.Deleted[0].ID != 4 || report.Deleted[1].ID != 1 {
+		t.Fatalf("Releases 4 and 1 expected to be pruned, got: %+v", report.Deleted)
+	}
+	if !strings.HasPrefix(report.String(), "Would delete 2 release(s)") {
+		t.Fatalf("Dry-run output expected, got: %s", report)
+	}
+	if _, ok := server.Release(testApp, 1); !ok {
+		t.Fatalf("Dry-run expected not to delete releases")
+	}
+
+	if _, err := app.PruneReleases(policy, false); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	for id, kept := range map[int]bool{1: false, 2: true, 3: true, 4: false, 5: true} {
+		if _, ok := server.Release(testApp, id); ok != kept {
+			t.Fatalf("Release %d kept: %v expected", id, kept)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package appcenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+// Destination types of the DestinationResult.
+const (
+	DestinationGroup  = "group"
+	DestinationStore  = "store"
+	DestinationTester = "tester"
+)
+
+// Destinations lists the distribution groups and stores by name and the testers by email.
+type Destinations struct {
+	Groups  []string
+	Stores  []string
+	Testers []string
+}
+
+// DestinationResult is the outcome of distributing the release to one destination.
+type DestinationResult struct {
+	Type string
+	Name string
+	// Skipped is set when the release was already distributed to the destination.
+	Skipped bool
+	Err     error
+}
+
+// RedistributeReport ...
+type RedistributeReport struct {
+	Results []DestinationResult
+}
+
+// Succeeded returns the results of the destinations the release was added to or was already present in.
+func (r RedistributeReport) Succeeded() []DestinationResult {
+	var succeeded []DestinationResult
+	for _, result := range r.Results {
+		if result.Err == nil {
+			succeeded = append(succeeded, result)
+		}
+	}
+
+	return succeeded
+}
+
+// Failed returns the results of the destinations which could not be added.
+func (r RedistributeReport) Failed() []DestinationResult {
+	var failed []DestinationResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Err joins the errors of the failed destinations, nil if every destination succeeded.
+func (r RedistributeReport) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s %s: %w", result.Type, result.Name, result.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Release loads an existing release by ID.
+func (a AppAPI) Release(releaseID int) (ReleaseAPI, error) {
+	return a.ReleaseContext(context.Background(), releaseID)
+}
+
+// ReleaseContext ...
+func (a AppAPI) ReleaseContext(ctx context.Context, releaseID int) (ReleaseAPI, error) {
+	release, err := a.API.GetAppReleaseDetailsContext(ctx, a.ReleaseOptions.App, releaseID)
+	if err != nil {
+		return ReleaseAPI{}, err
+	}
+
+	return CreateReleaseAPI(a.API, release, a.ReleaseOptions), nil
+}
+
+// LatestRelease loads the newest release of the app, it returns client.ErrReleaseNotFound if the app has no releases.
+func (a AppAPI) LatestRelease() (ReleaseAPI, error) {
+	return a.LatestReleaseContext(context.Background())
+}
+
+// LatestReleaseContext ...
+func (a AppAPI) LatestReleaseContext(ctx context.Context) (ReleaseAPI, error) {
+	releases, err := a.API.ListReleasesContext(ctx, a.ReleaseOptions.App, model.ReleaseListOptions{Limit: 1})
+	if err != nil {
+		return ReleaseAPI{}, err
+	}
+	if len(releases) == 0 {
+		return ReleaseAPI{}, client.ErrReleaseNotFound
+	}
+
+	return a.ReleaseContext(ctx, releases[0].ID)
+}
+
+// ReleaseByVersion loads the newest release with the given versions, an empty build matches every build.
+func (a AppAPI) ReleaseByVersion(shortVersion, build string) (ReleaseAPI, error) {
+	return a.ReleaseByVersionContext(context.Background(), shortVersion, build)
+}
+
+// ReleaseByVersionContext ...
+func (a AppAPI) ReleaseByVersionContext(ctx context.Context, shortVersion, build string) (ReleaseAPI, error) {
+	release, err := a.API.FindReleaseByVersionContext(ctx, a.ReleaseOptions.App, shortVersion, build)
+	if err != nil {
+		return ReleaseAPI{}, err
+	}
+
+	return CreateReleaseAPI(a.API, release, a.ReleaseOptions), nil
+}
+
+// Redistribute adds the release to the destinations it is not distributed to yet.
+// A failing destination does not stop the others, the outcome of each destination is in the report.
+func (r ReleaseAPI) Redistribute(destinations Destinations) RedistributeReport {
+	return r.RedistributeContext(context.Background(), destinations)
+}
+
+// RedistributeContext ...
+func (r ReleaseAPI) RedistributeContext(ctx context.Context, destinations Destinations) RedistributeReport {
+	var report RedistributeReport
+	app := r.ReleaseOptions.App
+
+	for _, name := range nonEmpty(destinations.Groups) {
+		result := DestinationResult{Type: DestinationGroup, Name: name}
+		group, err := resolveGroup(ctx, r.API, app, name)
+		switch {
+		case err != nil:
+			result.Err = err
+		case r.hasDestination(DestinationGroup, group.ID, group.Name):
+			result.Skipped = true
+		default:
+			result.Skipped, result.Err = alreadyDistributed(r.API.AddReleaseToGroupContext(ctx, group, r.Release.ID, r.ReleaseOptions))
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	for _, name := range nonEmpty(destinations.Stores) {
+		result := DestinationResult{Type: DestinationStore, Name: name}
+		store, err := r.API.GetStoreContext(ctx, name, app)
+		switch {
+		case err != nil:
+			result.Err = err
+		case r.hasDestination(DestinationStore, store.ID, store.Name):
+			result.Skipped = true
+		default:
+			result.Skipped, result.Err = alreadyDistributed(r.API.AddReleaseToStoreContext(ctx, store, r.Release.ID, r.ReleaseOptions))
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	for _, email := range nonEmpty(destinations.Testers) {
+		result := DestinationResult{Type: DestinationTester, Name: email}
+		if r.hasDestination(DestinationTester, "", email) {
+			result.Skipped = true
+		} else {
+			result.Skipped, result.Err = alreadyDistributed(r.API.AddTesterToReleaseContext(ctx, email, r.Release.ID, r.ReleaseOptions))
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	return report
+}
+
+// hasDestination reports whether the loaded release is already distributed to the destination, matched by ID or name.
+func (r ReleaseAPI) hasDestination(destinationType, id, name string) bool {
+	matches := func(destinationID, destinationName string) bool {
+		return (id != "" && destinationID == id) || strings.EqualFold(destinationName, name)
+	}
+
+	switch destinationType {
+	case DestinationGroup:
+		for _, g := range r.Release.DistributionGroups {
+			if matches(g.ID, g.Name) {
+				return true
+			}
+		}
+	case DestinationStore:
+		for _, s := range r.Release.DistributionStores {
+			if matches(s.ID, s.Name) {
+				return true
+			}
+		}
+	}
+
+	for _, d := range r.Release.Destinations {
+		if d.DestinationType != destinationType {
+			continue
+		}
+		if matches(d.ID, d.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// alreadyDistributed treats a conflict as a skipped destination, the release was distributed to it in the meantime.
+func alreadyDistributed(err error) (bool, error) {
+	if client.IsConflict(err) {
+		return true, nil
+	}
+
+	return false, err
+}
+
+func nonEmpty(values []string) []string {
+	var result []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
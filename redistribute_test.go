@@ -0,0 +1,70 @@
+package appcenter
+
+import (
+	"testing"
+
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestRedistribute(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	qa := server.AddGroup(testApp, model.Group{Name: "qa"})
+	server.AddGroup(testApp, model.Group{Name: "beta"})
+	server.AddStore(testApp, model.Store{Name: "production", Type: "googleplay"})
+	server.AddRelease(testApp, model.Release{ShortVersion: "1.0", Version: "1"})
+	latest := server.AddRelease(testApp, model.Release{
+		ShortVersion: "1.1",
+		Version:      "2",
+		Destinations: []model.ReleaseDestination{{ID: qa.ID, Name: qa.Name, DestinationType: "group"}},
+	})
+	app := newTestAppAPI(server)
+
+	r, err := app.LatestRelease()
+	if err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if r.Release.ID != latest.ID {
+		t.Fatalf("Release %d expected, got: %d", latest.ID, r.Release.ID)
+	}
+
+	report := r.Redistribute(Destinations{
+		Groups:  []string{"qa", "beta", "missing"},
+		Stores:  []string{"production"},
+		Testers: []string{"qa@example.com"},
+	})
+
+	want := []DestinationResult{
+		{Type: DestinationGroup, Name: "qa", Skipped: true},
+		{Type: DestinationGroup, Name: "beta"},
+		{Type: DestinationGroup, Name: "missing"},
+		{Type: DestinationStore, Name: "production"},
+		{Type: DestinationTester, Name: "qa@example.com"},
+	}
+	if len(report.Results) != len(want) {
+		t.Fatalf("%d results expected, got: %+v", len(want), report.Results)
+	}
+	for i, result := range report.Results {
+		if result.Type != want[i].Type || result.Name != want[i].Name || result.Skipped != want[i].Skipped {
+			t.Errorf("Result %d: %+v expected, got: %+v", i, want[i], result)
+		}
+		if failed := result.Name == "missing"; failed != (result.Err != nil) {
+			t.Errorf("Result %d: unexpected error: %v", i, result.Err)
+		}
+	}
+	if len(report.Failed()) != 1 || !client.IsNotFound(report.Failed()[0].Err) || report.Err() == nil {
+		t.Fatalf("The missing group should fail with not found, got: %v", report.Err())
+	}
+
+	got, _ := server.Release(testApp, latest.ID)
+	if len(got.Destinations) != 4 {
+		t.Fatalf("4 destinations expected, got: %+v", got.Destinations)
+	}
+
+	if _, err := app.ReleaseByVersion("1.0", ""); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package appcenter
 
 import (
+	"context"
 	"strings"
 
 	"github.com/bitrise-io/appcenter/client"
@@ -9,13 +10,13 @@ import (
 
 // ReleaseAPI ...
 type ReleaseAPI struct {
-	API            client.API
+	API            client.AppCenterAPI
 	Release        model.Release
 	ReleaseOptions model.ReleaseOptions
 }
 
 // CreateReleaseAPI ...
-func CreateReleaseAPI(api client.API, release model.Release, releaseOptions model.ReleaseOptions) ReleaseAPI {
+func CreateReleaseAPI(api client.AppCenterAPI, release model.Release, releaseOptions model.ReleaseOptions) ReleaseAPI {
 	return ReleaseAPI{
 		API:            api,
 		Release:        release,
@@ -28,14 +29,14 @@ func (r ReleaseAPI) AddGroup(g model.Group) error {
 	return r.API.AddReleaseToGroup(g, r.Release.ID, r.ReleaseOptions)
 }
 
-// AddGroupsToRelease ...
+// AddGroupsToRelease resolves the group names with the precedence of AppAPI.ResolveGroup.
 func (r ReleaseAPI) AddGroupsToRelease(groupNames []string) error {
 	if len(groupNames) > 0 {
 		for _, groupName := range groupNames {
 			if len(strings.TrimSpace(groupName)) == 0 {
 				continue
 			}
-			group, err := r.API.GetGroupByName(groupName, r.ReleaseOptions.App)
+			group, err := resolveGroup(context.Background(), r.API, r.ReleaseOptions.App, groupName)
 			if err != nil {
 				return err
 			}
@@ -55,17 +56,89 @@ func (r ReleaseAPI) AddStore(s model.Store) error {
 	return r.API.AddReleaseToStore(s, r.Release.ID, r.ReleaseOptions)
 }
 
+// WaitForStorePublish waits until the release added with AddStore is published to the store, or fails to be.
+func (r ReleaseAPI) WaitForStorePublish(s model.Store) (model.ReleaseDistributionStore, error) {
+	return r.WaitForStorePublishContext(context.Background(), s)
+}
+
+// WaitForStorePublishContext ...
+func (r ReleaseAPI) WaitForStorePublishContext(ctx context.Context, s model.Store) (model.ReleaseDistributionStore, error) {
+	return r.API.WaitForStorePublishContext(ctx, s, r.Release.ID, r.ReleaseOptions)
+}
+
 // AddTester ...
 func (r ReleaseAPI) AddTester(email string) error {
 	return r.API.AddTesterToRelease(email, r.Release.ID, r.ReleaseOptions)
 }
 
+// RemoveGroup ...
+func (r ReleaseAPI) RemoveGroup(g model.Group) error {
+	return r.API.RemoveReleaseFromGroup(g, r.Release.ID, r.ReleaseOptions)
+}
+
+// RemoveGroupsFromRelease pulls the release from the distribution groups with the given names.
+func (r ReleaseAPI) RemoveGroupsFromRelease(groupNames []string) error {
+	for _, groupName := range groupNames {
+		if len(strings.TrimSpace(groupName)) == 0 {
+			continue
+		}
+		group, err := resolveGroup(context.Background(), r.API, r.ReleaseOptions.App, groupName)
+		if err != nil {
+			return err
+		}
+
+		err = r.RemoveGroup(group)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveStore ...
+func (r ReleaseAPI) RemoveStore(s model.Store) error {
+	return r.API.RemoveReleaseFromStore(s, r.Release.ID, r.ReleaseOptions)
+}
+
+// RemoveTester ...
+func (r ReleaseAPI) RemoveTester(email string) error {
+	return r.API.RemoveTesterFromRelease(email, r.Release.ID, r.ReleaseOptions)
+}
+
 // SetReleaseNote ...
 func (r ReleaseAPI) SetReleaseNote(releaseNote string) error {
 	return r.API.SetReleaseNoteOnRelease(releaseNote, r.Release.ID, r.ReleaseOptions)
 }
 
+// Update ...
+func (r ReleaseAPI) Update(update model.ReleaseUpdate) error {
+	return r.API.UpdateRelease(update, r.Release.ID, r.ReleaseOptions)
+}
+
+// Enable ...
+func (r ReleaseAPI) Enable() error {
+	enabled := true
+	return r.Update(model.ReleaseUpdate{Enabled: &enabled})
+}
+
+// Disable makes the release unavailable for download without deleting it.
+func (r ReleaseAPI) Disable() error {
+	enabled := false
+	return r.Update(model.ReleaseUpdate{Enabled: &enabled})
+}
+
+// SetBuildInfo attaches the git information of the build to the release.
+func (r ReleaseAPI) SetBuildInfo(build model.ReleaseBuild) error {
+	return r.Update(model.ReleaseUpdate{Build: &build})
+}
+
 // UploadSymbol - build and version is required for Android and optional for iOS
 func (r ReleaseAPI) UploadSymbol(filePath string) error {
-	return r.API.UploadSymbolToRelease(filePath, r.Release, r.ReleaseOptions)
+	return r.UploadSymbolContext(context.Background(), filePath)
+}
+
+// UploadSymbolContext ...
+func (r ReleaseAPI) UploadSymbolContext(ctx context.Context, filePath string) error {
+	return r.API.UploadSymbolToReleaseContext(ctx, filePath, r.Release, r.ReleaseOptions)
 }
@@ -0,0 +1,145 @@
+package appcenter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bitrise-io/appcenter/appcentertest"
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/client/mock"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestAddGroupsToRelease(t *testing.T) {
+	var distributed []string
+	api := &mock.API{
+		GetGroupByNameFunc: func(_ context.Context, groupName string, _ model.App) (model.Group, error) {
+			return model.Group{ID: groupName + "-id", Name: groupName}, nil
+		},
+		AddReleaseToGroupFunc: func(_ context.Context, g model.Group, releaseID int, _ model.ReleaseOptions) error {
+			distributed = append(distributed, g.ID)
+			return nil
+		},
+	}
+
+	r := CreateReleaseAPI(api, model.Release{ID: 1}, model.ReleaseOptions{})
+	if err := r.AddGroupsToRelease([]string{"QA", " ", "Beta"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	if len(distributed) != 2 || distributed[0] != "QA-id" || distributed[1] != "Beta-id" {
+		t.Fatalf("Release expected to be distributed to QA and Beta, got: %v", distributed)
+	}
+}
+
+func TestUpdateRelease(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	release := server.AddRelease(testApp, model.Release{Enabled: true, ReleaseNotes: "notes"})
+	app := newTestAppAPI(server)
+	r := CreateReleaseAPI(app.API, release, app.ReleaseOptions)
+
+	if err := r.Disable(); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.SetBuildInfo(model.ReleaseBuild{BranchName: "main", CommitHash: "abc123", CommitMessage: "Fix crash"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	got, _ := server.Release(testApp, release.ID)
+	if got.Enabled || got.Build.BranchName != "main" || got.Build.CommitHash != "abc123" || got.ReleaseNotes != "notes" {
+		t.Fatalf("Disabled release with build info expected, got: %+v", got)
+	}
+}
+
+func TestRemoveDestinations(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	group := server.AddGroup(testApp, model.Group{Name: "beta"})
+	store := server.AddStore(testApp, model.Store{Name: "production", Type: "googleplay"})
+	release := server.AddRelease(testApp, model.Release{})
+	app := newTestAppAPI(server)
+	r := CreateReleaseAPI(app.API, release, app.ReleaseOptions)
+
+	for _, err := range []error{r.AddGroup(group), r.AddStore(store), r.AddTester("qa@example.com"), r.AddTester("dev@example.com")} {
+		if err != nil {
+			t.Fatalf("No error expected, got: %v", err)
+		}
+	}
+
+	if err := r.RemoveGroupsFromRelease([]string{"beta"}); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.RemoveStore(store); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if err := r.RemoveTester("QA@example.com"); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+
+	got, _ := server.Release(testApp, release.ID)
+	if len(got.DistributionGroups) != 0 || len(got.DistributionStores) != 0 || len(got.Destinations) != 1 {
+		t.Fatalf("Only the dev tester destination expected, got: %+v", got)
+	}
+	if testers := server.Testers(testApp, release.ID); len(testers) != 1 || testers[0] != "dev@example.com" {
+		t.Fatalf("Only the dev tester expected, got: %v", testers)
+	}
+
+	if err := r.RemoveTester("qa@example.com"); !client.IsNotFound(err) {
+		t.Fatalf("Not found error expected, got: %v", err)
+	}
+}
+
+func TestWaitForStorePublish(t *testing.T) {
+	server := appcentertest.NewServer()
+	defer server.Close()
+
+	store := server.AddStore(testApp, model.Store{Name: "production", Type: model.StoreTypeGooglePlay})
+	app := newTestAppAPI(server)
+
+	server.SetStorePublishing(model.PublishingStatusPublished, 2)
+	r := CreateReleaseAPI(app.API, server.AddRelease(testApp, model.Release{}), app.ReleaseOptions)
+	if err := r.AddStore(store); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	published, err := r.WaitForStorePublish(store)
+	if err != nil || published.PublishingStatus != model.PublishingStatusPublished {
+		t.Fatalf("Published store expected, got: %+v, %v", published, err)
+	}
+	if count := server.RequestCount(http.MethodGet, "/v0.1/apps/owner/app/releases/"); count != 3 {
+		t.Fatalf("3 release requests expected, got: %d", count)
+	}
+
+	server.SetStorePublishing(model.PublishingStatusFailed, 0)
+	r = CreateReleaseAPI(app.API, server.AddRelease(testApp, model.Release{}), app.ReleaseOptions)
+	if err := r.AddStore(store); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	var publishErr *client.StorePublishError
+	if _, err := r.WaitForStorePublish(store); !errors.As(err, &publishErr) || publishErr.Status != model.PublishingStatusFailed {
+		t.Fatalf("StorePublishError expected, got: %v", err)
+	}
+
+	server.SetStorePublishing(model.PublishingStatusPublished, 10)
+	api := client.New(appcentertest.Token,
+		client.WithBaseURL(server.URL),
+		client.WithPollInterval(time.Millisecond),
+		client.WithMaxPollAttempts(2),
+		client.WithLogger(client.NopLogger()))
+	r = CreateReleaseAPI(api, server.AddRelease(testApp, model.Release{}), app.ReleaseOptions)
+	if err := r.AddStore(store); err != nil {
+		t.Fatalf("No error expected, got: %v", err)
+	}
+	if _, err := r.WaitForStorePublish(store); !errors.Is(err, client.ErrStorePublishTimeout) {
+		t.Fatalf("ErrStorePublishTimeout expected, got: %v", err)
+	}
+
+	if _, err := r.WaitForStorePublish(model.Store{ID: "missing", Name: "missing"}); !client.IsNotFound(err) {
+		t.Fatalf("Not found expected, got: %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package appcenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const maxConcurrentTesterRequests = 5
+
+// TesterStatus ...
+type TesterStatus string
+
+// Outcomes of adding a tester to a release.
+const (
+	TesterAdded          TesterStatus = "added"
+	TesterAlreadyPresent TesterStatus = "already_present"
+	TesterInvalid        TesterStatus = "invalid"
+	TesterFailed         TesterStatus = "failed"
+)
+
+// TesterResult is the outcome of adding one email to the release, Err is set for invalid and failed testers.
+type TesterResult struct {
+	Email  string
+	Status TesterStatus
+	Err    error
+}
+
+// TesterResults ...
+type TesterResults []TesterResult
+
+// Err joins the errors of the invalid and failed testers, nil if every tester was added or already present.
+func (r TesterResults) Err() error {
+	var errs []error
+	for _, result := range r {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Email, result.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// AddTesters distributes the release to the testers, blank and duplicate emails are dropped.
+// A failing tester does not stop the others, the results are in the order of the emails.
+func (r ReleaseAPI) AddTesters(emails []string) TesterResults {
+	return r.AddTestersContext(context.Background(), emails)
+}
+
+// AddTestersContext ...
+func (r ReleaseAPI) AddTestersContext(ctx context.Context, emails []string) TesterResults {
+	emails = uniqueEmails(emails)
+	results := make(TesterResults, len(emails))
+
+	g := errgroup.Group{}
+	g.SetLimit(maxConcurrentTesterRequests)
+
+	for i, email := range emails {
+		i, email := i, email
+		results[i] = TesterResult{Email: email}
+
+		if err := validateEmail(email); err != nil {
+			results[i].Status, results[i].Err = TesterInvalid, err
+			continue
+		}
+		if r.hasDestination(DestinationTester, "", email) {
+			results[i].Status = TesterAlreadyPresent
+			continue
+		}
+
+		g.Go(func() error {
+			skipped, err := alreadyDistributed(r.API.AddTesterToReleaseContext(ctx, email, r.Release.ID, r.ReleaseOptions))
+			switch {
+			case err != nil:
+				results[i].Status, results[i].Err = TesterFailed, err
+			case skipped:
+				results[i].Status = TesterAlreadyPresent
+			default:
+				results[i].Status = TesterAdded
+			}
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	return results
+}
+
+// validateEmail accepts plain addresses only, without display name.
+func validateEmail(email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil || !strings.EqualFold(address.Address, email) {
+		return fmt.Errorf("invalid email address: %s", email)
+	}
+
+	return nil
+}
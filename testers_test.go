@@ -0,0 +1,58 @@
+package appcenter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bitrise-io/appcenter/client"
+	"github.com/bitrise-io/appcenter/client/mock"
+	"github.com/bitrise-io/appcenter/model"
+)
+
+func TestAddTesters(t *testing.T) {
+	api := &mock.API{
+		AddTesterToReleaseFunc: func(_ context.Context, email string, _ int, _ model.ReleaseOptions) error {
+			switch email {
+			case "bounce@example.com":
+				return &client.APIError{Method: http.MethodPost, StatusCode: http.StatusBadRequest}
+			case "late@example.com":
+				return &client.APIError{Method: http.MethodPost, StatusCode: http.StatusConflict}
+			}
+			return nil
+		},
+	}
+	release := model.Release{ID: 1, Destinations: []model.ReleaseDestination{{Name: "old@example.com", DestinationType: "tester"}}}
+	r := CreateReleaseAPI(api, release, model.ReleaseOptions{})
+
+	results := r.AddTesters([]string{
+		"qa@example.com", "QA@example.com ", "", "Old@example.com", "not-an-email", "Jane <jane@example.com>", "bounce@example.com", "late@example.com",
+	})
+
+	want := []TesterResult{
+		{Email: "qa@example.com", Status: TesterAdded},
+		{Email: "Old@example.com", Status: TesterAlreadyPresent},
+		{Email: "not-an-email", Status: TesterInvalid},
+		{Email: "Jane <jane@example.com>", Status: TesterInvalid},
+		{Email: "bounce@example.com", Status: TesterFailed},
+		{Email: "late@example.com", Status: TesterAlreadyPresent},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("%d results expected, got: %+v", len(want), results)
+	}
+	for i, result := range results {
+		if result.Email != want[i].Email || result.Status != want[i].Status {
+			t.Errorf("Result %d: %+v expected, got: %+v", i, want[i], result)
+		}
+		if (result.Err != nil) != (result.Status == TesterInvalid || result.Status == TesterFailed) {
+			t.Errorf("Result %d: unexpected error: %v", i, result.Err)
+		}
+	}
+
+	if calls := len(api.Calls()); calls != 3 {
+		t.Fatalf("3 tester requests expected, got: %d", calls)
+	}
+	if results.Err() == nil {
+		t.Fatal("Error expected for the invalid and failed testers")
+	}
+}
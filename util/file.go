@@ -1,14 +1,21 @@
 package util
 
 import (
-	"io/ioutil"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
 	"strings"
 )
 
-// LocalFile ...
+// LocalFile reads the file in a streaming manner: the content is not kept in memory,
+// so the file needs to be closed with Close after OpenFile.
 type LocalFile struct {
-	FilePath    string
-	FileContent []byte
+	FilePath string
+
+	file *os.File
+	size int64
 }
 
 // FileName ...
@@ -18,34 +25,70 @@ func (lf LocalFile) FileName() string {
 	return pathParts[len(pathParts)-1]
 }
 
-// OpenFile ...
+// OpenFile opens the file for reading. The content is not loaded into memory,
+// it is read section by section when the chunks are consumed.
 func (lf *LocalFile) OpenFile() error {
-	fb, err := ioutil.ReadFile(lf.FilePath)
+	f, err := os.Open(lf.FilePath)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
 	if err != nil {
+		if cerr := f.Close(); cerr != nil {
+			return cerr
+		}
 		return err
 	}
 
-	lf.FileContent = fb
+	lf.file = f
+	lf.size = info.Size()
+	return nil
+}
+
+// Close ...
+func (lf *LocalFile) Close() error {
+	if lf.file == nil {
+		return nil
+	}
+
+	err := lf.file.Close()
+	lf.file = nil
 	return err
 }
 
 // FileSize ...
-func (lf LocalFile) FileSize() int {
-	return len(lf.FileContent)
+func (lf LocalFile) FileSize() int64 {
+	return lf.size
 }
 
-// MakeChunks ...
-func (lf LocalFile) MakeChunks(limit int) [][]byte {
-	var retData [][]byte
-	for i := 0; i < len(lf.FileContent); i += limit {
-		batch := lf.FileContent[i:min(i+limit, len(lf.FileContent))]
+// SHA256 returns the hex encoded SHA-256 hash of the opened file, reading it in a streaming manner.
+func (lf LocalFile) SHA256() (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, io.NewSectionReader(lf.file, 0, lf.size)); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// MakeChunks returns readers for the consecutive limit sized sections of the file.
+// The file needs to be opened and kept open while the chunks are read.
+func (lf LocalFile) MakeChunks(limit int) ([]*io.SectionReader, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid chunk size: %d", limit)
+	}
+
+	var retData []*io.SectionReader
+	for i := int64(0); i < lf.size; i += int64(limit) {
+		batch := io.NewSectionReader(lf.file, i, min(int64(limit), lf.size-i))
 		retData = append(retData, batch)
 	}
 
-	return retData
+	return retData, nil
 }
 
-func min(a, b int) int {
+func min(a, b int64) int64 {
 	if a <= b {
 		return a
 	}